@@ -0,0 +1,185 @@
+// Package filters provides probabilistic membership filters.
+// Serialized filters of pointer-like element types are only valid in the process that built them.
+package filters
+
+import (
+	"encoding/binary"
+	"errors"
+	"math"
+	"math/bits"
+
+	"github.com/goiste/generics/internal/hashing"
+	"github.com/goiste/generics/sets"
+)
+
+var (
+	// ErrIncompatible is returned when merging filters built with different parameters
+	ErrIncompatible = errors.New("filters: incompatible filters")
+	// ErrInvalidData is returned when decoding malformed filter data
+	ErrInvalidData = errors.New("filters: invalid filter data")
+)
+
+const bloomMagic = "BLM1"
+
+// Bloom represents a Bloom filter for elements of type T.
+// Has never returns false for an added value, but may return true for a value that was not added.
+// The zero value is an empty filter that cannot be added to; create filters with NewBloom,
+// BloomFromSet or BloomFromSlice, or decode them with UnmarshalBinary.
+type Bloom[T comparable] struct {
+	words []uint64
+	m     uint64
+	k     uint32
+	n     uint64
+}
+
+// NewBloom
+// creates a Bloom filter sized for `expected` elements with the given false-positive rate
+// (0 < fpRate < 1); out-of-range arguments are clamped to at least one element and a rate of 0.5
+func NewBloom[T comparable](expected int, fpRate float64) *Bloom[T] {
+	if expected < 1 {
+		expected = 1
+	}
+	if fpRate <= 0 || fpRate >= 1 {
+		fpRate = 0.5
+	}
+
+	m := uint64(math.Ceil(-float64(expected) * math.Log(fpRate) / (math.Ln2 * math.Ln2)))
+	if m < 64 {
+		m = 64
+	}
+	k := uint32(math.Round(float64(m) / float64(expected) * math.Ln2))
+	if k < 1 {
+		k = 1
+	}
+
+	return &Bloom[T]{words: make([]uint64, (m+63)/64), m: m, k: k}
+}
+
+// BloomFromSet
+// creates a Bloom filter containing the values of the Set
+func BloomFromSet[T comparable](s sets.Set[T], fpRate float64) *Bloom[T] {
+	b := NewBloom[T](s.Len(), fpRate)
+	s.Each(func(v T) bool {
+		b.Add(v)
+		return true
+	})
+	return b
+}
+
+// BloomFromSlice
+// creates a Bloom filter containing the values of the slice
+func BloomFromSlice[T comparable](values []T, fpRate float64) *Bloom[T] {
+	b := NewBloom[T](len(values), fpRate)
+	b.Add(values...)
+	return b
+}
+
+// Add
+// adds values to the filter; panics if the filter is the zero value
+func (b *Bloom[T]) Add(values ...T) {
+	if b.m == 0 && len(values) > 0 {
+		panic("filters: Bloom must be created with NewBloom or UnmarshalBinary")
+	}
+	for _, v := range values {
+		h1, h2 := bloomHashes(v)
+		for i := uint32(0); i < b.k; i++ {
+			bit := (h1 + uint64(i)*h2) % b.m
+			b.words[bit>>6] |= 1 << (bit & 63)
+		}
+		b.n++
+	}
+}
+
+// Has
+// returns false if the value was definitely not added and true if it probably was
+func (b *Bloom[T]) Has(value T) bool {
+	if b.m == 0 {
+		return false
+	}
+	h1, h2 := bloomHashes(value)
+	for i := uint32(0); i < b.k; i++ {
+		bit := (h1 + uint64(i)*h2) % b.m
+		if b.words[bit>>6]&(1<<(bit&63)) == 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// Count
+// returns the number of Add operations performed on the filter and the merged filters
+func (b *Bloom[T]) Count() int {
+	return int(b.n)
+}
+
+// FalsePositiveRate
+// returns the estimated false-positive rate based on the share of set bits
+func (b *Bloom[T]) FalsePositiveRate() float64 {
+	if b.m == 0 {
+		return 0
+	}
+	set := 0
+	for _, w := range b.words {
+		set += bits.OnesCount64(w)
+	}
+	return math.Pow(float64(set)/float64(b.m), float64(b.k))
+}
+
+// Merge
+// adds the values of the other filters, which must have been created with the same parameters
+func (b *Bloom[T]) Merge(others ...*Bloom[T]) error {
+	for _, o := range others {
+		if o.m != b.m || o.k != b.k {
+			return ErrIncompatible
+		}
+	}
+	for _, o := range others {
+		for i, w := range o.words {
+			b.words[i] |= w
+		}
+		b.n += o.n
+	}
+	return nil
+}
+
+// MarshalBinary
+// implements encoding.BinaryMarshaler: the magic "BLM1", uint32 number of hashes,
+// uint64 number of bits, uint64 count and the bit words, all little-endian
+func (b *Bloom[T]) MarshalBinary() ([]byte, error) {
+	data := make([]byte, 24+8*len(b.words))
+	copy(data, bloomMagic)
+	binary.LittleEndian.PutUint32(data[4:], b.k)
+	binary.LittleEndian.PutUint64(data[8:], b.m)
+	binary.LittleEndian.PutUint64(data[16:], b.n)
+	for i, w := range b.words {
+		binary.LittleEndian.PutUint64(data[24+8*i:], w)
+	}
+	return data, nil
+}
+
+// UnmarshalBinary
+// implements encoding.BinaryUnmarshaler, replacing the filter contents and parameters
+func (b *Bloom[T]) UnmarshalBinary(data []byte) error {
+	if len(data) < 24 || string(data[:4]) != bloomMagic {
+		return ErrInvalidData
+	}
+	k := binary.LittleEndian.Uint32(data[4:])
+	m := binary.LittleEndian.Uint64(data[8:])
+	n := binary.LittleEndian.Uint64(data[16:])
+	data = data[24:]
+	if k == 0 || m == 0 || (m+63)/64 != uint64(len(data))/8 || len(data)%8 != 0 {
+		return ErrInvalidData
+	}
+
+	words := make([]uint64, len(data)/8)
+	for i := range words {
+		words[i] = binary.LittleEndian.Uint64(data[8*i:])
+	}
+	*b = Bloom[T]{words: words, m: m, k: k, n: n}
+	return nil
+}
+
+func bloomHashes[T comparable](value T) (uint64, uint64) {
+	h := hashing.Sum64(value)
+	return h, hashing.Mix(h) | 1
+}
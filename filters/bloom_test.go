@@ -0,0 +1,142 @@
+package filters
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/goiste/generics/sets"
+)
+
+const errorFormat = "\ngot: %+v\nexp: %+v\n"
+
+func TestNewBloom(t *testing.T) {
+	tests := []struct {
+		name     string
+		expected int
+		fpRate   float64
+		m        uint64
+		k        uint32
+	}{
+		{name: "clamped", expected: 0, fpRate: 2, m: 64, k: 44},
+		{name: "1000_1%", expected: 1000, fpRate: 0.01, m: 9586, k: 7},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := NewBloom[int](tt.expected, tt.fpRate)
+			if got, exp := []any{b.m, b.k}, []any{tt.m, tt.k}; fmt.Sprint(got) != fmt.Sprint(exp) {
+				t.Errorf(errorFormat, got, exp)
+			}
+		})
+	}
+}
+
+func TestBloom_ZeroValue(t *testing.T) {
+	var b Bloom[int]
+	if b.Has(1) || b.Count() != 0 || b.FalsePositiveRate() != 0 {
+		t.Errorf(errorFormat, b.Has(1), false)
+	}
+	b.Add()
+
+	const exp = "filters: Bloom must be created with NewBloom or UnmarshalBinary"
+	defer func() {
+		if got := recover(); got != exp {
+			t.Errorf(errorFormat, got, exp)
+		}
+	}()
+	b.Add(1)
+}
+
+func TestBloom_Has(t *testing.T) {
+	const n = 10_000
+	b := NewBloom[string](n, 0.01)
+	for i := 0; i < n; i++ {
+		b.Add(fmt.Sprintf("key-%d", i))
+	}
+
+	for i := 0; i < n; i++ {
+		if !b.Has(fmt.Sprintf("key-%d", i)) {
+			t.Fatalf(errorFormat, false, true)
+		}
+	}
+
+	fp := 0
+	for i := 0; i < n; i++ {
+		if b.Has(fmt.Sprintf("other-%d", i)) {
+			fp++
+		}
+	}
+	if rate := float64(fp) / n; rate > 0.02 {
+		t.Errorf(errorFormat, rate, "<= 0.02")
+	}
+	if got := b.Count(); got != n {
+		t.Errorf(errorFormat, got, n)
+	}
+	if rate := b.FalsePositiveRate(); rate > 0.02 {
+		t.Errorf(errorFormat, rate, "<= 0.02")
+	}
+}
+
+func TestBloomFrom(t *testing.T) {
+	values := []int{1, 2, 3, 4, 5}
+	for name, b := range map[string]*Bloom[int]{
+		"set":   BloomFromSet(sets.Make[int](values...), 0.01),
+		"slice": BloomFromSlice(values, 0.01),
+	} {
+		t.Run(name, func(t *testing.T) {
+			for _, v := range values {
+				if !b.Has(v) {
+					t.Errorf(errorFormat, false, true)
+				}
+			}
+		})
+	}
+}
+
+func TestBloom_Merge(t *testing.T) {
+	a, b := NewBloom[int](100, 0.01), NewBloom[int](100, 0.01)
+	a.Add(1, 2)
+	b.Add(3)
+	if err := a.Merge(b); err != nil {
+		t.Fatal(err)
+	}
+	for _, v := range []int{1, 2, 3} {
+		if !a.Has(v) {
+			t.Errorf(errorFormat, false, true)
+		}
+	}
+	if got := a.Count(); got != 3 {
+		t.Errorf(errorFormat, got, 3)
+	}
+
+	if err := a.Merge(NewBloom[int](1000, 0.01)); !errors.Is(err, ErrIncompatible) {
+		t.Errorf(errorFormat, err, ErrIncompatible)
+	}
+}
+
+func TestBloom_Binary(t *testing.T) {
+	b := BloomFromSlice([]string{"a", "b", "c"}, 0.01)
+	data, err := b.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got := &Bloom[string]{}
+	if err = got.UnmarshalBinary(data); err != nil {
+		t.Fatal(err)
+	}
+	for _, v := range []string{"a", "b", "c"} {
+		if !got.Has(v) {
+			t.Errorf(errorFormat, false, true)
+		}
+	}
+	if got.Count() != b.Count() || got.m != b.m || got.k != b.k {
+		t.Errorf(errorFormat, got, b)
+	}
+
+	for _, data := range [][]byte{nil, []byte("BLM2" + string(data[4:])), data[:len(data)-1]} {
+		if err := got.UnmarshalBinary(data); !errors.Is(err, ErrInvalidData) {
+			t.Errorf(errorFormat, err, ErrInvalidData)
+		}
+	}
+}
@@ -0,0 +1,311 @@
+package filters
+
+import (
+	"encoding/binary"
+	"errors"
+	"math"
+	"math/bits"
+
+	"github.com/goiste/generics/internal/hashing"
+	"github.com/goiste/generics/sets"
+)
+
+// ErrFull is returned when a value cannot be placed into a Cuckoo filter
+var ErrFull = errors.New("filters: cuckoo filter is full")
+
+const (
+	cuckooMagic      = "CKO1"
+	bucketSize       = 4
+	maxKicks         = 500
+	cuckooLoadFactor = 0.95
+	cuckooHeader     = 34
+	cuckooSeed       = 0x9e3779b97f4a7c15
+)
+
+// Cuckoo represents a cuckoo filter for elements of type T.
+// Unlike a Bloom filter it supports Delete; Has never returns false for an added value,
+// but may return true for a value that was not added.
+// The zero value is an empty filter that cannot be added to; create filters with NewCuckoo,
+// CuckooFromSet or CuckooFromSlice, or decode them with UnmarshalBinary.
+type Cuckoo[T comparable] struct {
+	buckets []bucket
+	victim  victim
+	fpBits  uint32
+	count   uint64
+	rnd     uint64
+}
+
+// NewCuckoo
+// creates a Cuckoo filter sized for `expected` elements with the given false-positive rate
+// (0 < fpRate < 1); fingerprints are at most 16 bits, which bounds the lowest achievable rate
+func NewCuckoo[T comparable](expected int, fpRate float64) *Cuckoo[T] {
+	if expected < 1 {
+		expected = 1
+	}
+	if fpRate <= 0 || fpRate >= 1 {
+		fpRate = 0.5
+	}
+
+	fpBits := uint32(math.Ceil(math.Log2(2 * bucketSize / fpRate)))
+	if fpBits < 4 {
+		fpBits = 4
+	}
+	if fpBits > 16 {
+		fpBits = 16
+	}
+
+	n := uint64(math.Ceil(float64(expected) / bucketSize / cuckooLoadFactor))
+	buckets := uint64(1) << bits.Len64(n-1)
+
+	return &Cuckoo[T]{buckets: make([]bucket, buckets), fpBits: fpBits, rnd: cuckooSeed}
+}
+
+// CuckooFromSet
+// creates a Cuckoo filter containing the values of the Set
+func CuckooFromSet[T comparable](s sets.Set[T], fpRate float64) (*Cuckoo[T], error) {
+	c := NewCuckoo[T](s.Len(), fpRate)
+	var err error
+	s.Each(func(v T) bool {
+		err = c.Add(v)
+		return err == nil
+	})
+	return c, err
+}
+
+// CuckooFromSlice
+// creates a Cuckoo filter containing the values of the slice
+func CuckooFromSlice[T comparable](values []T, fpRate float64) (*Cuckoo[T], error) {
+	c := NewCuckoo[T](len(values), fpRate)
+	return c, c.Add(values...)
+}
+
+// Add
+// adds values to the filter; returns ErrFull if a value cannot be placed,
+// in which case the values before it have been added; panics if the filter is the zero value
+func (c *Cuckoo[T]) Add(values ...T) error {
+	if len(c.buckets) == 0 && len(values) > 0 {
+		panic("filters: Cuckoo must be created with NewCuckoo or UnmarshalBinary")
+	}
+	for _, v := range values {
+		i, fp := c.index(v)
+		if !c.insert(i, fp) {
+			return ErrFull
+		}
+	}
+	return nil
+}
+
+// Has
+// returns false if the value was definitely not added and true if it probably was
+func (c *Cuckoo[T]) Has(value T) bool {
+	if len(c.buckets) == 0 {
+		return false
+	}
+	i1, fp := c.index(value)
+	i2 := c.alt(i1, fp)
+	if c.buckets[i1].has(fp) || c.buckets[i2].has(fp) {
+		return true
+	}
+	return c.victim.used && c.victim.fp == fp && (c.victim.index == i1 || c.victim.index == i2)
+}
+
+// Delete
+// removes one occurrence of the value and returns true if its fingerprint was found;
+// deleting a value that was never added may remove another value sharing its fingerprint
+func (c *Cuckoo[T]) Delete(value T) bool {
+	if len(c.buckets) == 0 {
+		return false
+	}
+	i1, fp := c.index(value)
+	i2 := c.alt(i1, fp)
+	if c.victim.used && c.victim.fp == fp && (c.victim.index == i1 || c.victim.index == i2) {
+		c.victim = victim{}
+		c.count--
+		return true
+	}
+	if !c.buckets[i1].remove(fp) && !c.buckets[i2].remove(fp) {
+		return false
+	}
+	c.count--
+	if c.victim.used {
+		// a slot has been freed, so the victim can be placed again
+		v := c.victim
+		c.victim = victim{}
+		c.count--
+		c.insert(v.index, v.fp)
+	}
+	return true
+}
+
+// Len
+// returns the number of fingerprints stored in the filter
+func (c *Cuckoo[T]) Len() int {
+	return int(c.count)
+}
+
+// Merge
+// adds the fingerprints of the other filters, which must have been created with the same parameters;
+// returns ErrFull if the filter runs out of space, in which case the fingerprints
+// inserted before that stay in the filter
+func (c *Cuckoo[T]) Merge(others ...*Cuckoo[T]) error {
+	for _, o := range others {
+		if len(o.buckets) != len(c.buckets) || o.fpBits != c.fpBits {
+			return ErrIncompatible
+		}
+	}
+	for _, o := range others {
+		for i := range o.buckets {
+			for _, fp := range o.buckets[i] {
+				if fp != 0 && !c.insert(uint64(i), fp) {
+					return ErrFull
+				}
+			}
+		}
+		if o.victim.used && !c.insert(o.victim.index, o.victim.fp) {
+			return ErrFull
+		}
+	}
+	return nil
+}
+
+// MarshalBinary
+// implements encoding.BinaryMarshaler: the magic "CKO1", uint32 fingerprint bits,
+// uint64 number of buckets, uint64 count, uint16 victim fingerprint (0 if none),
+// uint64 victim bucket and four uint16 fingerprints per bucket, all little-endian
+func (c *Cuckoo[T]) MarshalBinary() ([]byte, error) {
+	data := make([]byte, cuckooHeader+2*bucketSize*len(c.buckets))
+	copy(data, cuckooMagic)
+	binary.LittleEndian.PutUint32(data[4:], c.fpBits)
+	binary.LittleEndian.PutUint64(data[8:], uint64(len(c.buckets)))
+	binary.LittleEndian.PutUint64(data[16:], c.count)
+	if c.victim.used {
+		binary.LittleEndian.PutUint16(data[24:], c.victim.fp)
+		binary.LittleEndian.PutUint64(data[26:], c.victim.index)
+	}
+	k := cuckooHeader
+	for i := range c.buckets {
+		for _, fp := range c.buckets[i] {
+			binary.LittleEndian.PutUint16(data[k:], fp)
+			k += 2
+		}
+	}
+	return data, nil
+}
+
+// UnmarshalBinary
+// implements encoding.BinaryUnmarshaler, replacing the filter contents and parameters
+func (c *Cuckoo[T]) UnmarshalBinary(data []byte) error {
+	if len(data) < cuckooHeader || string(data[:4]) != cuckooMagic {
+		return ErrInvalidData
+	}
+	fpBits := binary.LittleEndian.Uint32(data[4:])
+	n := binary.LittleEndian.Uint64(data[8:])
+	count := binary.LittleEndian.Uint64(data[16:])
+	v := victim{fp: binary.LittleEndian.Uint16(data[24:]), index: binary.LittleEndian.Uint64(data[26:])}
+	v.used = v.fp != 0
+	data = data[cuckooHeader:]
+	if fpBits < 4 || fpBits > 16 || n == 0 || n&(n-1) != 0 || n > uint64(len(data)) ||
+		uint64(len(data)) != 2*bucketSize*n || (v.used && v.index >= n) {
+		return ErrInvalidData
+	}
+
+	buckets := make([]bucket, n)
+	for i := range buckets {
+		for j := range buckets[i] {
+			buckets[i][j] = binary.LittleEndian.Uint16(data[2*(bucketSize*i+j):])
+		}
+	}
+	*c = Cuckoo[T]{buckets: buckets, victim: v, fpBits: fpBits, count: count, rnd: cuckooSeed}
+	return nil
+}
+
+// index returns the primary bucket and the non-zero fingerprint of the value
+func (c *Cuckoo[T]) index(value T) (uint64, uint16) {
+	h := hashing.Sum64(value)
+	fp := uint16(h>>32) & (1<<c.fpBits - 1)
+	if fp == 0 {
+		fp = 1
+	}
+	return h & uint64(len(c.buckets)-1), fp
+}
+
+// alt returns the other bucket of a fingerprint; alt(alt(i, fp), fp) == i
+func (c *Cuckoo[T]) alt(i uint64, fp uint16) uint64 {
+	return (i ^ hashing.Mix(uint64(fp))) & uint64(len(c.buckets)-1)
+}
+
+func (c *Cuckoo[T]) insert(i1 uint64, fp uint16) bool {
+	if c.victim.used {
+		return false
+	}
+
+	i2 := c.alt(i1, fp)
+	if c.buckets[i1].insert(fp) || c.buckets[i2].insert(fp) {
+		c.count++
+		return true
+	}
+
+	i := i1
+	if c.random()&1 == 1 {
+		i = i2
+	}
+	for k := 0; k < maxKicks; k++ {
+		slot := c.random() % bucketSize
+		fp, c.buckets[i][slot] = c.buckets[i][slot], fp
+		i = c.alt(i, fp)
+		if c.buckets[i].insert(fp) {
+			c.count++
+			return true
+		}
+	}
+
+	// keep the last evicted fingerprint aside so that no added value is lost
+	c.victim = victim{index: i, fp: fp, used: true}
+	c.count++
+	return true
+}
+
+func (c *Cuckoo[T]) random() uint64 {
+	c.rnd ^= c.rnd << 13
+	c.rnd ^= c.rnd >> 7
+	c.rnd ^= c.rnd << 17
+	return c.rnd
+}
+
+// victim holds a fingerprint that could not be placed after maxKicks relocations
+type victim struct {
+	index uint64
+	fp    uint16
+	used  bool
+}
+
+type bucket [bucketSize]uint16
+
+func (b *bucket) has(fp uint16) bool {
+	for _, f := range b {
+		if f == fp {
+			return true
+		}
+	}
+	return false
+}
+
+func (b *bucket) insert(fp uint16) bool {
+	for i, f := range b {
+		if f == 0 {
+			b[i] = fp
+			return true
+		}
+	}
+	return false
+}
+
+func (b *bucket) remove(fp uint16) bool {
+	for i, f := range b {
+		if f == fp {
+			b[i] = 0
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,200 @@
+package filters
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/goiste/generics/sets"
+)
+
+func TestNewCuckoo(t *testing.T) {
+	tests := []struct {
+		name     string
+		expected int
+		fpRate   float64
+		buckets  int
+		fpBits   uint32
+	}{
+		{name: "clamped", expected: 0, fpRate: 0, buckets: 1, fpBits: 4},
+		{name: "1000_1%", expected: 1000, fpRate: 0.01, buckets: 512, fpBits: 10},
+		{name: "tiny_rate", expected: 10, fpRate: 1e-9, buckets: 4, fpBits: 16},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := NewCuckoo[int](tt.expected, tt.fpRate)
+			if got, exp := fmt.Sprint(len(c.buckets), c.fpBits), fmt.Sprint(tt.buckets, tt.fpBits); got != exp {
+				t.Errorf(errorFormat, got, exp)
+			}
+		})
+	}
+}
+
+func TestCuckoo_ZeroValue(t *testing.T) {
+	var c Cuckoo[int]
+	if c.Has(1) || c.Delete(1) || c.Len() != 0 {
+		t.Errorf(errorFormat, c.Has(1), false)
+	}
+	if err := c.Add(); err != nil {
+		t.Fatal(err)
+	}
+
+	const exp = "filters: Cuckoo must be created with NewCuckoo or UnmarshalBinary"
+	defer func() {
+		if got := recover(); got != exp {
+			t.Errorf(errorFormat, got, exp)
+		}
+	}()
+	_ = c.Add(1)
+}
+
+func TestCuckoo_HasDelete(t *testing.T) {
+	const n = 10_000
+	c := NewCuckoo[int](n, 0.01)
+	for i := 0; i < n; i++ {
+		if err := c.Add(i); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for i := 0; i < n; i++ {
+		if !c.Has(i) {
+			t.Fatalf(errorFormat, false, true)
+		}
+	}
+
+	fp := 0
+	for i := n; i < 2*n; i++ {
+		if c.Has(i) {
+			fp++
+		}
+	}
+	if rate := float64(fp) / n; rate > 0.02 {
+		t.Errorf(errorFormat, rate, "<= 0.02")
+	}
+
+	for i := 0; i < n; i += 2 {
+		if !c.Delete(i) {
+			t.Fatalf(errorFormat, false, true)
+		}
+	}
+	if got := c.Len(); got != n/2 {
+		t.Errorf(errorFormat, got, n/2)
+	}
+	for i := 1; i < n; i += 2 {
+		if !c.Has(i) {
+			t.Fatalf(errorFormat, false, true)
+		}
+	}
+}
+
+func TestCuckoo_Full(t *testing.T) {
+	c := NewCuckoo[int](8, 0.1)
+	added := make([]int, 0)
+	var err error
+	for i := 0; i < 1000 && err == nil; i++ {
+		if err = c.Add(i); err == nil {
+			added = append(added, i)
+		}
+	}
+	if !errors.Is(err, ErrFull) {
+		t.Fatalf(errorFormat, err, ErrFull)
+	}
+	for _, v := range added {
+		if !c.Has(v) {
+			t.Fatalf(errorFormat, false, true)
+		}
+	}
+	if got := c.Len(); got != len(added) {
+		t.Errorf(errorFormat, got, len(added))
+	}
+
+	// freeing a slot re-places the stashed victim and makes room again
+	c.Delete(added[0])
+	for _, v := range added[1:] {
+		if !c.Has(v) {
+			t.Fatalf(errorFormat, false, true)
+		}
+	}
+}
+
+func TestCuckooFrom(t *testing.T) {
+	values := []string{"a", "b", "c"}
+	fromSet, err := CuckooFromSet(sets.Make[string](values...), 0.01)
+	if err != nil {
+		t.Fatal(err)
+	}
+	fromSlice, err := CuckooFromSlice(values, 0.01)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, c := range []*Cuckoo[string]{fromSet, fromSlice} {
+		for _, v := range values {
+			if !c.Has(v) {
+				t.Errorf(errorFormat, false, true)
+			}
+		}
+	}
+}
+
+func TestCuckoo_Merge(t *testing.T) {
+	a, b := NewCuckoo[int](100, 0.01), NewCuckoo[int](100, 0.01)
+	_ = a.Add(1, 2)
+	_ = b.Add(3)
+	if err := a.Merge(b); err != nil {
+		t.Fatal(err)
+	}
+	for _, v := range []int{1, 2, 3} {
+		if !a.Has(v) {
+			t.Errorf(errorFormat, false, true)
+		}
+	}
+	if got := a.Len(); got != 3 {
+		t.Errorf(errorFormat, got, 3)
+	}
+
+	if err := a.Merge(NewCuckoo[int](10_000, 0.01)); !errors.Is(err, ErrIncompatible) {
+		t.Errorf(errorFormat, err, ErrIncompatible)
+	}
+
+	// a Merge that runs out of space keeps the fingerprints inserted before that
+	full := NewCuckoo[int](100, 0.01)
+	for i, err := 0, error(nil); err == nil; i++ {
+		err = full.Add(i)
+	}
+	before := a.Len()
+	if err := a.Merge(full); !errors.Is(err, ErrFull) {
+		t.Fatalf(errorFormat, err, ErrFull)
+	}
+	if got := a.Len(); got <= before || got >= before+full.Len() {
+		t.Errorf(errorFormat, got, before)
+	}
+}
+
+func TestCuckoo_Binary(t *testing.T) {
+	c := NewCuckoo[int](8, 0.1)
+	for i := 0; c.Add(i) == nil; i++ {
+	}
+	data, err := c.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got := &Cuckoo[int]{}
+	if err = got.UnmarshalBinary(data); err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < c.Len(); i++ {
+		if c.Has(i) != got.Has(i) {
+			t.Errorf(errorFormat, got.Has(i), c.Has(i))
+		}
+	}
+	if got.Len() != c.Len() || got.victim != c.victim {
+		t.Errorf(errorFormat, got.Len(), c.Len())
+	}
+
+	for _, data := range [][]byte{nil, []byte("CKO2" + string(data[4:])), data[:len(data)-1]} {
+		if err := got.UnmarshalBinary(data); !errors.Is(err, ErrInvalidData) {
+			t.Errorf(errorFormat, err, ErrInvalidData)
+		}
+	}
+}
@@ -0,0 +1,114 @@
+// Package fakedb provides an in-memory database/sql driver for tests.
+//
+// Exec stores its first argument under the statement text; Query takes a statement text
+// as its only argument and returns the value stored under it as a single-row, single-column result.
+package fakedb
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"io"
+	"sync"
+)
+
+// DriverName is the name the driver is registered under
+const DriverName = "generics-fakedb"
+
+var (
+	once   sync.Once
+	mu     sync.Mutex
+	stored = map[string]driver.Value{}
+)
+
+// Open
+// returns a database backed by the in-memory driver
+func Open() (*sql.DB, error) {
+	once.Do(func() {
+		sql.Register(DriverName, fakeDriver{})
+	})
+	return sql.Open(DriverName, "")
+}
+
+// Stored
+// returns the value stored by the statement with the given key
+func Stored(key string) driver.Value {
+	mu.Lock()
+	defer mu.Unlock()
+	return stored[key]
+}
+
+type fakeDriver struct{}
+
+func (fakeDriver) Open(string) (driver.Conn, error) {
+	return conn{}, nil
+}
+
+type conn struct{}
+
+func (conn) Prepare(query string) (driver.Stmt, error) {
+	return stmt{query: query}, nil
+}
+
+func (conn) Close() error {
+	return nil
+}
+
+func (conn) Begin() (driver.Tx, error) {
+	return nil, errors.New("fakedb: transactions are not supported")
+}
+
+type stmt struct {
+	query string
+}
+
+func (stmt) Close() error {
+	return nil
+}
+
+func (stmt) NumInput() int {
+	return -1
+}
+
+func (s stmt) Exec(args []driver.Value) (driver.Result, error) {
+	if len(args) == 0 {
+		return nil, errors.New("fakedb: nothing to store")
+	}
+	mu.Lock()
+	stored[s.query] = args[0]
+	mu.Unlock()
+	return driver.RowsAffected(1), nil
+}
+
+func (s stmt) Query(args []driver.Value) (driver.Rows, error) {
+	if len(args) != 1 {
+		return nil, errors.New("fakedb: query expects the key as the only argument")
+	}
+	key, ok := args[0].(string)
+	if !ok {
+		return nil, errors.New("fakedb: key must be a string")
+	}
+	return &rows{value: Stored(key)}, nil
+}
+
+type rows struct {
+	value driver.Value
+	done  bool
+}
+
+func (*rows) Columns() []string {
+	return []string{"value"}
+}
+
+func (*rows) Close() error {
+	return nil
+}
+
+func (r *rows) Next(dest []driver.Value) error {
+	if r.done {
+		return io.EOF
+	}
+	r.done = true
+	dest[0] = r.value
+	return nil
+}
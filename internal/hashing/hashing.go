@@ -0,0 +1,161 @@
+package hashing
+
+import (
+	"math"
+	"reflect"
+)
+
+const (
+	offset64 = 14695981039346656037
+	prime64  = 1099511628211
+)
+
+// Sum64
+// returns a deterministic 64-bit hash of the value
+//
+// Values that are equal according to == have equal hashes, so the result can be used
+// for sharding, filters and sketches. Pointers, channels and unsafe pointers are hashed
+// by address, so their hashes are only stable within a single process.
+func Sum64[T comparable](value T) uint64 {
+	return Seeded(0, value)
+}
+
+// Seeded
+// returns a deterministic 64-bit hash of the value mixed with the seed
+func Seeded[T comparable](seed uint64, value T) uint64 {
+	h := hasher(offset64)
+	h.writeUint64(seed)
+
+	switch v := any(value).(type) {
+	case string:
+		h.writeString(v)
+	case int:
+		h.writeUint64(uint64(v))
+	case int8:
+		h.writeUint64(uint64(v))
+	case int16:
+		h.writeUint64(uint64(v))
+	case int32:
+		h.writeUint64(uint64(v))
+	case int64:
+		h.writeUint64(uint64(v))
+	case uint:
+		h.writeUint64(uint64(v))
+	case uint8:
+		h.writeUint64(uint64(v))
+	case uint16:
+		h.writeUint64(uint64(v))
+	case uint32:
+		h.writeUint64(uint64(v))
+	case uint64:
+		h.writeUint64(v)
+	case uintptr:
+		h.writeUint64(uint64(v))
+	case bool:
+		h.writeBool(v)
+	case float32:
+		h.writeFloat(float64(v))
+	case float64:
+		h.writeFloat(v)
+	default:
+		h = withValue(h, value)
+	}
+
+	return mix(uint64(h))
+}
+
+// withValue hashes a composite value by reflection; it takes its own copy of the value
+// and is kept out of line so that only this fallback makes the value escape to the heap
+//
+//go:noinline
+func withValue[T comparable](h hasher, value T) hasher {
+	h.writeValue(reflect.ValueOf(&value).Elem())
+	return h
+}
+
+// Mix
+// returns a well-distributed 64-bit value derived from x (splitmix64 finalizer)
+func Mix(x uint64) uint64 {
+	return mix(x)
+}
+
+type hasher uint64
+
+func (h *hasher) writeByte(b byte) {
+	*h = (*h ^ hasher(b)) * prime64
+}
+
+func (h *hasher) writeUint64(v uint64) {
+	for i := 0; i < 8; i++ {
+		h.writeByte(byte(v >> (8 * i)))
+	}
+}
+
+func (h *hasher) writeString(s string) {
+	h.writeUint64(uint64(len(s)))
+	for i := 0; i < len(s); i++ {
+		h.writeByte(s[i])
+	}
+}
+
+func (h *hasher) writeBool(b bool) {
+	if b {
+		h.writeByte(1)
+		return
+	}
+	h.writeByte(0)
+}
+
+func (h *hasher) writeFloat(f float64) {
+	if f == 0 {
+		// +0 and -0 are equal, so they must hash equally
+		f = 0
+	}
+	h.writeUint64(math.Float64bits(f))
+}
+
+func (h *hasher) writeValue(v reflect.Value) {
+	switch v.Kind() {
+	case reflect.String:
+		h.writeString(v.String())
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		h.writeUint64(uint64(v.Int()))
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		h.writeUint64(v.Uint())
+	case reflect.Bool:
+		h.writeBool(v.Bool())
+	case reflect.Float32, reflect.Float64:
+		h.writeFloat(v.Float())
+	case reflect.Complex64, reflect.Complex128:
+		c := v.Complex()
+		h.writeFloat(real(c))
+		h.writeFloat(imag(c))
+	case reflect.Pointer, reflect.Chan, reflect.UnsafePointer:
+		h.writeUint64(uint64(v.Pointer()))
+	case reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			h.writeValue(v.Index(i))
+		}
+	case reflect.Struct:
+		for i := 0; i < v.NumField(); i++ {
+			h.writeValue(v.Field(i))
+		}
+	case reflect.Interface:
+		if v.IsNil() {
+			h.writeByte(0)
+			return
+		}
+		e := v.Elem()
+		h.writeString(e.Type().String())
+		h.writeValue(e)
+	}
+}
+
+func mix(x uint64) uint64 {
+	x ^= x >> 30
+	x *= 0xbf58476d1ce4e5b9
+	x ^= x >> 27
+	x *= 0x94d049bb133111eb
+	x ^= x >> 31
+	return x
+}
@@ -0,0 +1,74 @@
+package hashing
+
+import (
+	"math"
+	"testing"
+)
+
+const errorFormat = "\ngot: %+v\nexp: %+v\n"
+
+type point struct {
+	x, y float64
+	name string
+}
+
+func TestSum64(t *testing.T) {
+	type myString string
+
+	tests := []struct {
+		name string
+		a, b uint64
+		eq   bool
+	}{
+		{name: "string_equal", a: Sum64("one"), b: Sum64("one"), eq: true},
+		{name: "string_differ", a: Sum64("one"), b: Sum64("two"), eq: false},
+		{name: "int_equal", a: Sum64(42), b: Sum64(42), eq: true},
+		{name: "int_differ", a: Sum64(1), b: Sum64(2), eq: false},
+		{name: "zero_sign", a: Sum64(0.0), b: Sum64(math.Copysign(0, -1)), eq: true},
+		{name: "named_type", a: Sum64(myString("one")), b: Sum64(myString("one")), eq: true},
+		{name: "struct_equal", a: Sum64(point{1, 2, "a"}), b: Sum64(point{1, 2, "a"}), eq: true},
+		{name: "struct_differ", a: Sum64(point{1, 2, "a"}), b: Sum64(point{2, 1, "a"}), eq: false},
+		{name: "struct_zero_sign", a: Sum64(point{}), b: Sum64(point{x: math.Copysign(0, -1)}), eq: true},
+		{name: "array", a: Sum64([2]string{"a", "b"}), b: Sum64([2]string{"a", "b"}), eq: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.a == tt.b; got != tt.eq {
+				t.Errorf(errorFormat, got, tt.eq)
+			}
+		})
+	}
+}
+
+func TestSeeded(t *testing.T) {
+	if Seeded(1, "one") == Seeded(2, "one") {
+		t.Errorf(errorFormat, "equal hashes", "different hashes")
+	}
+	if Seeded(0, "one") != Sum64("one") {
+		t.Errorf(errorFormat, "different hashes", "equal hashes")
+	}
+}
+
+func TestSeeded_Allocs(t *testing.T) {
+	tests := []struct {
+		name string
+		hash func()
+	}{
+		{name: "int", hash: func() { Sum64(42) }},
+		{name: "uint8", hash: func() { Sum64(uint8(42)) }},
+		{name: "float64", hash: func() { Sum64(4.2) }},
+		{name: "bool", hash: func() { Sum64(true) }},
+		{name: "string", hash: func() { Sum64("forty-two") }},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if allocs := testing.AllocsPerRun(100, tt.hash); allocs != 0 {
+				t.Errorf(errorFormat, allocs, 0)
+			}
+		})
+	}
+
+	if Sum64(point{1, 2, "p"}) != Sum64(point{1, 2, "p"}) {
+		t.Errorf(errorFormat, "different hashes", "equal hashes")
+	}
+}
@@ -0,0 +1,189 @@
+package pgarray
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// ErrSyntax is returned when the input is not a valid one-dimensional array literal
+var ErrSyntax = errors.New("pgarray: invalid array literal")
+
+// Format
+// returns the Postgres array literal for the values; strings are always quoted
+func Format[T any](values []T) (string, error) {
+	var b strings.Builder
+	b.WriteByte('{')
+	for i := range values {
+		if i > 0 {
+			b.WriteByte(',')
+		}
+		if err := formatElem(&b, reflect.ValueOf(&values[i]).Elem()); err != nil {
+			return "", err
+		}
+	}
+	b.WriteByte('}')
+	return b.String(), nil
+}
+
+func formatElem(b *strings.Builder, v reflect.Value) error {
+	switch v.Kind() {
+	case reflect.String:
+		b.WriteByte('"')
+		s := v.String()
+		for i := 0; i < len(s); i++ {
+			if s[i] == '"' || s[i] == '\\' {
+				b.WriteByte('\\')
+			}
+			b.WriteByte(s[i])
+		}
+		b.WriteByte('"')
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		b.WriteString(strconv.FormatInt(v.Int(), 10))
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		b.WriteString(strconv.FormatUint(v.Uint(), 10))
+	case reflect.Float32, reflect.Float64:
+		f := v.Float()
+		switch {
+		case math.IsInf(f, 1):
+			b.WriteString("Infinity")
+		case math.IsInf(f, -1):
+			b.WriteString("-Infinity")
+		default:
+			b.WriteString(strconv.FormatFloat(f, 'g', -1, v.Type().Bits()))
+		}
+	case reflect.Bool:
+		if v.Bool() {
+			b.WriteByte('t')
+		} else {
+			b.WriteByte('f')
+		}
+	default:
+		return fmt.Errorf("pgarray: unsupported element type %s", v.Type())
+	}
+	return nil
+}
+
+// Parse
+// parses a one-dimensional Postgres array literal into values of type T;
+// NULL elements and nested arrays are not supported
+func Parse[T any](src string) ([]T, error) {
+	elems, err := split(src)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]T, len(elems))
+	for i := range elems {
+		if err = parseElem(elems[i], reflect.ValueOf(&result[i]).Elem()); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+func split(src string) ([]string, error) {
+	src = strings.TrimSpace(src)
+	if len(src) < 2 || src[0] != '{' || src[len(src)-1] != '}' {
+		return nil, ErrSyntax
+	}
+	body := src[1 : len(src)-1]
+
+	elems := make([]string, 0)
+	if strings.TrimSpace(body) == "" {
+		return elems, nil
+	}
+
+	for i := 0; ; {
+		for i < len(body) && body[i] == ' ' {
+			i++
+		}
+		if i >= len(body) {
+			return nil, ErrSyntax
+		}
+
+		var elem string
+		switch body[i] {
+		case '{':
+			return nil, fmt.Errorf("%w: nested arrays are not supported", ErrSyntax)
+		case '"':
+			var b strings.Builder
+			i++
+			for ; i < len(body) && body[i] != '"'; i++ {
+				if body[i] == '\\' {
+					i++
+					if i >= len(body) {
+						return nil, ErrSyntax
+					}
+				}
+				b.WriteByte(body[i])
+			}
+			if i >= len(body) {
+				return nil, ErrSyntax
+			}
+			i++
+			elem = b.String()
+		default:
+			start := i
+			for i < len(body) && body[i] != ',' {
+				i++
+			}
+			elem = strings.TrimSpace(body[start:i])
+			if elem == "" || body[start] == '"' {
+				return nil, ErrSyntax
+			}
+			if strings.EqualFold(elem, "NULL") {
+				return nil, fmt.Errorf("%w: NULL elements are not supported", ErrSyntax)
+			}
+		}
+		elems = append(elems, elem)
+
+		for i < len(body) && body[i] == ' ' {
+			i++
+		}
+		if i == len(body) {
+			return elems, nil
+		}
+		if body[i] != ',' {
+			return nil, ErrSyntax
+		}
+		i++
+	}
+}
+
+func parseElem(s string, v reflect.Value) error {
+	switch v.Kind() {
+	case reflect.String:
+		v.SetString(s)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(s, 10, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetInt(n)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		n, err := strconv.ParseUint(s, 10, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetUint(n)
+	case reflect.Float32, reflect.Float64:
+		f, err := strconv.ParseFloat(s, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetFloat(f)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(s)
+		if err != nil {
+			return err
+		}
+		v.SetBool(b)
+	default:
+		return fmt.Errorf("pgarray: unsupported element type %s", v.Type())
+	}
+	return nil
+}
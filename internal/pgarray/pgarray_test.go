@@ -0,0 +1,99 @@
+package pgarray
+
+import (
+	"errors"
+	"math"
+	"reflect"
+	"testing"
+)
+
+const errorFormat = "\ngot: %+v\nexp: %+v\n"
+
+func TestFormat(t *testing.T) {
+	type status string
+
+	tests := []struct {
+		name  string
+		input func() (string, error)
+		exp   string
+	}{
+		{name: "empty", input: func() (string, error) { return Format([]int{}) }, exp: `{}`},
+		{name: "int", input: func() (string, error) { return Format([]int{1, -2, 3}) }, exp: `{1,-2,3}`},
+		{name: "uint", input: func() (string, error) { return Format([]uint16{1, 2}) }, exp: `{1,2}`},
+		{name: "float", input: func() (string, error) { return Format([]float64{1.5, math.Inf(1), math.Inf(-1)}) }, exp: `{1.5,Infinity,-Infinity}`},
+		{name: "bool", input: func() (string, error) { return Format([]bool{true, false}) }, exp: `{t,f}`},
+		{name: "string", input: func() (string, error) { return Format([]string{"a b", `q"u\o`, "", "NULL"}) }, exp: `{"a b","q\"u\\o","","NULL"}`},
+		{name: "named", input: func() (string, error) { return Format([]status{"on"}) }, exp: `{"on"}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.input()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tt.exp {
+				t.Errorf(errorFormat, got, tt.exp)
+			}
+		})
+	}
+
+	t.Run("unsupported", func(t *testing.T) {
+		if _, err := Format([]struct{}{{}}); err == nil {
+			t.Errorf(errorFormat, err, "error")
+		}
+	})
+}
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		exp   []string
+		err   error
+	}{
+		{name: "empty", input: `{}`, exp: []string{}},
+		{name: "spaces", input: ` { } `, exp: []string{}},
+		{name: "unquoted", input: `{a,b c, d }`, exp: []string{"a", "b c", "d"}},
+		{name: "quoted", input: `{"a,b","q\"u\\o",""}`, exp: []string{"a,b", `q"u\o`, ""}},
+		{name: "quoted_null", input: `{"NULL"}`, exp: []string{"NULL"}},
+		{name: "null", input: `{a,NULL}`, err: ErrSyntax},
+		{name: "nested", input: `{{1,2},{3,4}}`, err: ErrSyntax},
+		{name: "no_braces", input: `a,b`, err: ErrSyntax},
+		{name: "unterminated", input: `{"a}`, err: ErrSyntax},
+		{name: "empty_elem", input: `{a,,b}`, err: ErrSyntax},
+		{name: "trailing_comma", input: `{a,}`, err: ErrSyntax},
+		{name: "junk_after_quote", input: `{"a"b}`, err: ErrSyntax},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Parse[string](tt.input)
+			if !errors.Is(err, tt.err) {
+				t.Errorf(errorFormat, err, tt.err)
+			}
+			if !reflect.DeepEqual(got, tt.exp) {
+				t.Errorf(errorFormat, got, tt.exp)
+			}
+		})
+	}
+}
+
+func TestParse_Types(t *testing.T) {
+	ints, err := Parse[int64](`{1,-2,3}`)
+	if exp := []int64{1, -2, 3}; err != nil || !reflect.DeepEqual(ints, exp) {
+		t.Errorf(errorFormat, ints, exp)
+	}
+	floats, err := Parse[float64](`{1.5,Infinity,-Infinity}`)
+	if exp := []float64{1.5, math.Inf(1), math.Inf(-1)}; err != nil || !reflect.DeepEqual(floats, exp) {
+		t.Errorf(errorFormat, floats, exp)
+	}
+	bools, err := Parse[bool](`{t,f,true}`)
+	if exp := []bool{true, false, true}; err != nil || !reflect.DeepEqual(bools, exp) {
+		t.Errorf(errorFormat, bools, exp)
+	}
+	if _, err = Parse[int8](`{300}`); err == nil {
+		t.Errorf(errorFormat, err, "error")
+	}
+	if _, err = Parse[uint](`{x}`); err == nil {
+		t.Errorf(errorFormat, err, "error")
+	}
+}
@@ -0,0 +1,232 @@
+// Package iters provides lazy, allocation-free pipelines over sequences of values.
+//
+// Seq and Seq2 have the same shape as iter.Seq and iter.Seq2 from Go 1.23, so on newer
+// toolchains they can be ranged over directly and converted to and from the standard types.
+// Adapters do not consume their input until the resulting sequence is iterated, and
+// a sequence can be iterated again if its source can.
+package iters
+
+import "github.com/goiste/generics/sets"
+
+// Seq represents a sequence of values: it calls yield for each value until yield returns false
+type Seq[T any] func(yield func(T) bool)
+
+// Seq2 represents a sequence of pairs of values
+type Seq2[K, V any] func(yield func(K, V) bool)
+
+// FromSlice
+// returns a sequence of the slice elements
+func FromSlice[T any](s []T) Seq[T] {
+	return func(yield func(T) bool) {
+		for i := range s {
+			if !yield(s[i]) {
+				return
+			}
+		}
+	}
+}
+
+// FromSet
+// returns a sequence of the Set elements in unspecified order
+func FromSet[T comparable](s sets.Set[T]) Seq[T] {
+	return s.Each
+}
+
+// Filter
+// returns a sequence of the values for which the func returns true
+func Filter[T any](seq Seq[T], f func(T) bool) Seq[T] {
+	return func(yield func(T) bool) {
+		seq(func(v T) bool {
+			return !f(v) || yield(v)
+		})
+	}
+}
+
+// Map
+// returns a sequence of the values returned by the func for each value
+func Map[T, U any](seq Seq[T], f func(T) U) Seq[U] {
+	return func(yield func(U) bool) {
+		seq(func(v T) bool {
+			return yield(f(v))
+		})
+	}
+}
+
+// Take
+// returns a sequence of the first `n` values
+func Take[T any](seq Seq[T], n int) Seq[T] {
+	return func(yield func(T) bool) {
+		if n <= 0 {
+			return
+		}
+		left := n
+		seq(func(v T) bool {
+			left--
+			return yield(v) && left > 0
+		})
+	}
+}
+
+// Skip
+// returns a sequence of the values after the first `n`
+func Skip[T any](seq Seq[T], n int) Seq[T] {
+	return func(yield func(T) bool) {
+		skipped := 0
+		seq(func(v T) bool {
+			if skipped < n {
+				skipped++
+				return true
+			}
+			return yield(v)
+		})
+	}
+}
+
+// TakeWhile
+// returns a sequence of the leading values for which the func returns true
+func TakeWhile[T any](seq Seq[T], f func(T) bool) Seq[T] {
+	return func(yield func(T) bool) {
+		seq(func(v T) bool {
+			return f(v) && yield(v)
+		})
+	}
+}
+
+// Chunk
+// returns a sequence of consecutive slices of up to `size` values; the last one may be shorter.
+// Each chunk is a new slice. Returns an empty sequence if size is not positive
+func Chunk[T any](seq Seq[T], size int) Seq[[]T] {
+	return func(yield func([]T) bool) {
+		if size <= 0 {
+			return
+		}
+		var chunk []T
+		stopped := false
+		seq(func(v T) bool {
+			if chunk == nil {
+				chunk = make([]T, 0, size)
+			}
+			chunk = append(chunk, v)
+			if len(chunk) < size {
+				return true
+			}
+			full := chunk
+			chunk = nil
+			stopped = !yield(full)
+			return !stopped
+		})
+		if !stopped && len(chunk) > 0 {
+			yield(chunk)
+		}
+	}
+}
+
+// Zip
+// returns a sequence of pairs of values from both sequences; it ends with the shorter one
+func Zip[A, B any](a Seq[A], b Seq[B]) Seq2[A, B] {
+	return func(yield func(A, B) bool) {
+		next, stop := pull(b)
+		defer stop()
+		a(func(x A) bool {
+			y, ok := next()
+			return ok && yield(x, y)
+		})
+	}
+}
+
+// Enumerate
+// returns a sequence of the values paired with their zero-based indexes
+func Enumerate[T any](seq Seq[T]) Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		i := -1
+		seq(func(v T) bool {
+			i++
+			return yield(i, v)
+		})
+	}
+}
+
+// Concat
+// returns a sequence of the values of all the sequences, one after another
+func Concat[T any](seqs ...Seq[T]) Seq[T] {
+	return func(yield func(T) bool) {
+		stopped := false
+		for _, seq := range seqs {
+			seq(func(v T) bool {
+				stopped = !yield(v)
+				return !stopped
+			})
+			if stopped {
+				return
+			}
+		}
+	}
+}
+
+// Distinct
+// returns a sequence of the values without repetitions, keeping the first occurrences
+func Distinct[T comparable](seq Seq[T]) Seq[T] {
+	return func(yield func(T) bool) {
+		seen := map[T]struct{}{}
+		seq(func(v T) bool {
+			if _, exists := seen[v]; exists {
+				return true
+			}
+			seen[v] = struct{}{}
+			return yield(v)
+		})
+	}
+}
+
+// Keys
+// returns a sequence of the first values of the pairs
+func Keys[K, V any](seq Seq2[K, V]) Seq[K] {
+	return func(yield func(K) bool) {
+		seq(func(k K, _ V) bool {
+			return yield(k)
+		})
+	}
+}
+
+// Values
+// returns a sequence of the second values of the pairs
+func Values[K, V any](seq Seq2[K, V]) Seq[V] {
+	return func(yield func(V) bool) {
+		seq(func(_ K, v V) bool {
+			return yield(v)
+		})
+	}
+}
+
+// Collect
+// returns the values of the sequence as a slice
+func Collect[T any](seq Seq[T]) []T {
+	result := []T{}
+	seq(func(v T) bool {
+		result = append(result, v)
+		return true
+	})
+	return result
+}
+
+// ToSet
+// returns the values of the sequence as a Set
+func ToSet[T comparable](seq Seq[T]) sets.Set[T] {
+	result := sets.Make[T]()
+	seq(func(v T) bool {
+		result[v] = struct{}{}
+		return true
+	})
+	return result
+}
+
+// Reduce
+// folds the sequence starting with the initial accumulator
+func Reduce[T, A any](seq Seq[T], initial A, f func(acc A, value T) A) A {
+	acc := initial
+	seq(func(v T) bool {
+		acc = f(acc, v)
+		return true
+	})
+	return acc
+}
@@ -0,0 +1,29 @@
+//go:build go1.23
+
+package iters
+
+import (
+	"iter"
+	"reflect"
+	"testing"
+)
+
+func TestSeq_Range(t *testing.T) {
+	got := make([]int, 0)
+	for v := range Filter(FromSlice(intSlice), isOdd) {
+		got = append(got, v)
+		if v == 3 {
+			break
+		}
+	}
+	if exp := []int{1, 3}; !reflect.DeepEqual(got, exp) {
+		t.Errorf(errorFormat, got, exp)
+	}
+
+	std := iter.Seq2[int, int](Enumerate(FromSlice(intSlice)))
+	for i, v := range std {
+		if v != intSlice[i] {
+			t.Errorf(errorFormat, v, intSlice[i])
+		}
+	}
+}
@@ -0,0 +1,267 @@
+package iters
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/goiste/generics/sets"
+	"github.com/goiste/generics/slices"
+)
+
+const errorFormat = "\ngot: %+v\nexp: %+v\n"
+
+var intSlice = []int{1, 2, 3, 4, 5}
+
+// counting returns a sequence of the slice elements that counts how many of them were produced
+func counting(s []int, produced *int) Seq[int] {
+	return func(yield func(int) bool) {
+		for _, v := range s {
+			*produced++
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+func isOdd(i int) bool { return i%2 == 1 }
+
+func TestAdapters(t *testing.T) {
+	tests := []struct {
+		name string
+		seq  Seq[int]
+		exp  []int
+	}{
+		{name: "slice", seq: FromSlice(intSlice), exp: intSlice},
+		{name: "empty slice", seq: FromSlice([]int(nil)), exp: []int{}},
+		{name: "filter", seq: Filter(FromSlice(intSlice), isOdd), exp: []int{1, 3, 5}},
+		{name: "map", seq: Map(FromSlice(intSlice), func(i int) int { return i * i }), exp: []int{1, 4, 9, 16, 25}},
+		{name: "take", seq: Take(FromSlice(intSlice), 2), exp: []int{1, 2}},
+		{name: "take zero", seq: Take(FromSlice(intSlice), 0), exp: []int{}},
+		{name: "take more", seq: Take(FromSlice(intSlice), 10), exp: intSlice},
+		{name: "skip", seq: Skip(FromSlice(intSlice), 3), exp: []int{4, 5}},
+		{name: "skip all", seq: Skip(FromSlice(intSlice), 10), exp: []int{}},
+		{name: "take while", seq: TakeWhile(FromSlice(intSlice), func(i int) bool { return i < 3 }), exp: []int{1, 2}},
+		{name: "concat", seq: Concat(FromSlice([]int{1}), FromSlice([]int{}), FromSlice([]int{2, 3})), exp: []int{1, 2, 3}},
+		{name: "concat none", seq: Concat[int](), exp: []int{}},
+		{name: "distinct", seq: Distinct(FromSlice([]int{3, 1, 3, 2, 1})), exp: []int{3, 1, 2}},
+		{name: "keys", seq: Keys(Enumerate(FromSlice([]string{"a", "b"}))), exp: []int{0, 1}},
+		{name: "values", seq: Values(Zip(FromSlice([]string{"a", "b"}), FromSlice(intSlice))), exp: []int{1, 2}},
+		{name: "chain", seq: Take(Skip(Map(Filter(FromSlice(intSlice), isOdd), func(i int) int { return i * 10 }), 1), 5), exp: []int{30, 50}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Collect(tt.seq); !reflect.DeepEqual(got, tt.exp) {
+				t.Errorf(errorFormat, got, tt.exp)
+			}
+			if got := Collect(tt.seq); !reflect.DeepEqual(got, tt.exp) {
+				t.Errorf("second iteration"+errorFormat, got, tt.exp)
+			}
+		})
+	}
+}
+
+func TestLaziness(t *testing.T) {
+	tests := []struct {
+		name     string
+		build    func(Seq[int]) Seq[int]
+		produced int
+	}{
+		{name: "take", build: func(s Seq[int]) Seq[int] { return Take(s, 2) }, produced: 2},
+		{name: "take while", build: func(s Seq[int]) Seq[int] { return TakeWhile(s, func(i int) bool { return i < 3 }) }, produced: 3},
+		{name: "filter take", build: func(s Seq[int]) Seq[int] { return Take(Filter(s, isOdd), 2) }, produced: 3},
+		{name: "chunk take", build: func(s Seq[int]) Seq[int] {
+			return Map(Take(Chunk(s, 2), 1), func(c []int) int { return len(c) })
+		}, produced: 2},
+		{name: "concat take", build: func(s Seq[int]) Seq[int] { return Take(Concat(s, s), 1) }, produced: 1},
+		{name: "distinct take", build: func(s Seq[int]) Seq[int] { return Take(Distinct(s), 1) }, produced: 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			produced := 0
+			seq := tt.build(counting(intSlice, &produced))
+			if produced != 0 {
+				t.Errorf(errorFormat, produced, 0)
+			}
+			Collect(seq)
+			if produced != tt.produced {
+				t.Errorf(errorFormat, produced, tt.produced)
+			}
+		})
+	}
+}
+
+func TestChunk(t *testing.T) {
+	tests := []struct {
+		name  string
+		input []int
+		size  int
+		exp   [][]int
+	}{
+		{name: "empty", input: []int{}, size: 2, exp: [][]int{}},
+		{name: "zero size", input: intSlice, size: 0, exp: [][]int{}},
+		{name: "even", input: []int{1, 2, 3, 4}, size: 2, exp: [][]int{{1, 2}, {3, 4}}},
+		{name: "rest", input: intSlice, size: 2, exp: [][]int{{1, 2}, {3, 4}, {5}}},
+		{name: "matches Split", input: intSlice, size: 3, exp: slices.Split(intSlice, 3)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Collect(Chunk(FromSlice(tt.input), tt.size)); !reflect.DeepEqual(got, tt.exp) {
+				t.Errorf(errorFormat, got, tt.exp)
+			}
+		})
+	}
+}
+
+func TestZip(t *testing.T) {
+	tests := []struct {
+		name string
+		a    []string
+		b    []int
+		exp  []string
+	}{
+		{name: "empty", a: nil, b: intSlice, exp: []string{}},
+		{name: "shorter a", a: []string{"a", "b"}, b: intSlice, exp: []string{"a1", "b2"}},
+		{name: "shorter b", a: []string{"a", "b", "c"}, b: []int{1}, exp: []string{"a1"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := []string{}
+			Zip(FromSlice(tt.a), FromSlice(tt.b))(func(s string, i int) bool {
+				got = append(got, fmt.Sprint(s, i))
+				return true
+			})
+			if !reflect.DeepEqual(got, tt.exp) {
+				t.Errorf(errorFormat, got, tt.exp)
+			}
+		})
+	}
+
+	produced := 0
+	got := Collect(Keys(Zip(FromSlice(intSlice), counting(intSlice, &produced))))
+	if exp := intSlice; !reflect.DeepEqual(got, exp) || produced != len(intSlice) {
+		t.Errorf(errorFormat, got, exp)
+	}
+}
+
+func TestEnumerate(t *testing.T) {
+	got := map[int]string{}
+	Enumerate(FromSlice([]string{"a", "b", "c"}))(func(i int, s string) bool {
+		got[i] = s
+		return i < 1
+	})
+	if exp := map[int]string{0: "a", 1: "b"}; !reflect.DeepEqual(got, exp) {
+		t.Errorf(errorFormat, got, exp)
+	}
+}
+
+func TestPullChan(t *testing.T) {
+	next, stop := pullChan(FromSlice([]int{1, 2, 3}))
+	for _, exp := range []int{1, 2, 3} {
+		if got, ok := next(); !ok || got != exp {
+			t.Errorf(errorFormat, got, exp)
+		}
+	}
+	if got, ok := next(); ok {
+		t.Errorf(errorFormat, got, nil)
+	}
+	stop()
+
+	produced := 0
+	next, stop = pullChan(counting(intSlice, &produced))
+	next()
+	stop()
+	stop()
+	if _, ok := next(); ok || produced != 1 {
+		t.Errorf(errorFormat, produced, 1)
+	}
+
+	_, stop = pullChan(counting(intSlice, &produced))
+	stop()
+}
+
+func TestFromSet(t *testing.T) {
+	s := sets.Make[int](1, 2, 3)
+	if got := ToSet(FromSet(s)); !got.Equals(s) {
+		t.Errorf(errorFormat, got, s)
+	}
+	if got := Collect(Take(FromSet(s), 2)); len(got) != 2 || !s.HasAll(got...) {
+		t.Errorf(errorFormat, got, s)
+	}
+	sorted := sets.MakeSorted[int](3, 1, 2)
+	if got := Collect(Seq[int](sorted.Each)); !reflect.DeepEqual(got, []int{1, 2, 3}) {
+		t.Errorf(errorFormat, got, []int{1, 2, 3})
+	}
+}
+
+func TestToSet(t *testing.T) {
+	got := ToSet(Map(FromSlice(intSlice), func(i int) int { return i % 3 }))
+	if exp := sets.Make[int](0, 1, 2); !got.Equals(exp) {
+		t.Errorf(errorFormat, got, exp)
+	}
+}
+
+func TestReduce(t *testing.T) {
+	tests := []struct {
+		name string
+		seq  Seq[int]
+		exp  string
+	}{
+		{name: "empty", seq: FromSlice([]int{}), exp: ">"},
+		{name: "all", seq: FromSlice(intSlice), exp: ">12345"},
+		{name: "filtered", seq: Filter(FromSlice(intSlice), isOdd), exp: ">135"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Reduce(tt.seq, ">", func(acc string, i int) string { return acc + fmt.Sprint(i) })
+			if got != tt.exp {
+				t.Errorf(errorFormat, got, tt.exp)
+			}
+		})
+	}
+}
+
+func TestPipeline_Allocs(t *testing.T) {
+	allocs := make([]float64, 0, 2)
+	for _, n := range []int{10, 10_000} {
+		s := slices.Range(0, n, 1)
+		allocs = append(allocs, testing.AllocsPerRun(10, func() {
+			pipeline(s)
+		}))
+	}
+	if allocs[0] != allocs[1] {
+		t.Errorf(errorFormat, allocs[1], allocs[0])
+	}
+}
+
+func pipeline(s []int) int {
+	seq := Map(Filter(FromSlice(s), isOdd), func(i int) int { return i * 3 })
+	return Reduce(Skip(seq, 1), 0, func(acc, v int) int { return acc + v })
+}
+
+func pipelineSlices(s []int) int {
+	result := slices.Map(slices.Filter(s, isOdd), func(i int) int { return i * 3 })
+	return slices.Sum(slices.SafeSlice(result, 1, len(result)))
+}
+
+func BenchmarkPipeline(b *testing.B) {
+	for _, n := range []int{1_000, 100_000} {
+		s := slices.Range(0, n, 1)
+		if pipeline(s) != pipelineSlices(s) {
+			b.Fatal("pipelines disagree")
+		}
+		b.Run(fmt.Sprintf("iters/n=%d", n), func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				pipeline(s)
+			}
+		})
+		b.Run(fmt.Sprintf("slices/n=%d", n), func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				pipelineSlices(s)
+			}
+		})
+	}
+}
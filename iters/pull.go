@@ -0,0 +1,69 @@
+package iters
+
+// pullChan converts a push sequence into a pull iterator by running it in a goroutine;
+// stop must be called to release the goroutine if the sequence is not exhausted
+func pullChan[T any](seq Seq[T]) (next func() (T, bool), stop func()) {
+	var (
+		requests = make(chan struct{})
+		values   = make(chan T)
+		done     = make(chan struct{})
+		stopped  = make(chan struct{})
+		started  bool
+		finished bool
+	)
+
+	run := func() {
+		defer close(done)
+		select {
+		case <-requests:
+		case <-stopped:
+			return
+		}
+		seq(func(v T) bool {
+			values <- v
+			select {
+			case <-requests:
+				return true
+			case <-stopped:
+				return false
+			}
+		})
+	}
+
+	next = func() (T, bool) {
+		var zero T
+		if finished {
+			return zero, false
+		}
+		if !started {
+			started = true
+			go run()
+		}
+		select {
+		case requests <- struct{}{}:
+		case <-done:
+			finished = true
+			return zero, false
+		}
+		select {
+		case v := <-values:
+			return v, true
+		case <-done:
+			finished = true
+			return zero, false
+		}
+	}
+
+	stop = func() {
+		if finished {
+			return
+		}
+		finished = true
+		if started {
+			close(stopped)
+			<-done
+		}
+	}
+
+	return next, stop
+}
@@ -0,0 +1,7 @@
+//go:build !go1.23
+
+package iters
+
+func pull[T any](seq Seq[T]) (func() (T, bool), func()) {
+	return pullChan(seq)
+}
@@ -0,0 +1,9 @@
+//go:build go1.23
+
+package iters
+
+import "iter"
+
+func pull[T any](seq Seq[T]) (func() (T, bool), func()) {
+	return iter.Pull(iter.Seq[T](seq))
+}
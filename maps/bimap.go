@@ -0,0 +1,124 @@
+package maps
+
+import (
+	"errors"
+
+	"github.com/goiste/generics/sets"
+)
+
+// ErrConflict is returned by BiMap.Put when the key or the value is already bound to another entry
+var ErrConflict = errors.New("maps: conflicting BiMap entry")
+
+// ConflictPolicy
+// defines how BiMap.Put handles a key or a value that is already bound to another entry
+type ConflictPolicy int
+
+const (
+	// ConflictError rejects the entry with ErrConflict
+	ConflictError ConflictPolicy = iota
+	// ConflictOverwrite removes the entries bound to the key or the value and stores the new one
+	ConflictOverwrite
+	// ConflictKeep silently keeps the existing entries
+	ConflictKeep
+)
+
+// BiMap represents a one-to-one mapping between keys of type K and values of type V
+// that can be looked up in both directions
+type BiMap[K, V comparable] struct {
+	forward  map[K]V
+	backward map[V]K
+	policy   ConflictPolicy
+}
+
+// MakeBiMap
+// creates a new empty BiMap with the given conflict policy
+func MakeBiMap[K, V comparable](policy ConflictPolicy) *BiMap[K, V] {
+	return &BiMap[K, V]{forward: map[K]V{}, backward: map[V]K{}, policy: policy}
+}
+
+// Put
+// binds the key and the value to each other, resolving conflicts according to the policy
+func (b *BiMap[K, V]) Put(key K, value V) error {
+	oldValue, keyExists := b.forward[key]
+	oldKey, valueExists := b.backward[value]
+	if keyExists && oldValue == value {
+		return nil
+	}
+
+	if keyExists || valueExists {
+		switch b.policy {
+		case ConflictKeep:
+			return nil
+		case ConflictOverwrite:
+			if keyExists {
+				delete(b.backward, oldValue)
+			}
+			if valueExists {
+				delete(b.forward, oldKey)
+			}
+		default:
+			return ErrConflict
+		}
+	}
+
+	b.forward[key] = value
+	b.backward[value] = key
+	return nil
+}
+
+// GetByKey
+// returns the value bound to the key and true, or the zero value and false if there is none
+func (b *BiMap[K, V]) GetByKey(key K) (V, bool) {
+	v, ok := b.forward[key]
+	return v, ok
+}
+
+// GetByValue
+// returns the key bound to the value and true, or the zero value and false if there is none
+func (b *BiMap[K, V]) GetByValue(value V) (K, bool) {
+	k, ok := b.backward[value]
+	return k, ok
+}
+
+// DeleteByKey
+// deletes the entry with the key
+func (b *BiMap[K, V]) DeleteByKey(key K) {
+	if v, ok := b.forward[key]; ok {
+		delete(b.forward, key)
+		delete(b.backward, v)
+	}
+}
+
+// DeleteByValue
+// deletes the entry with the value
+func (b *BiMap[K, V]) DeleteByValue(value V) {
+	if k, ok := b.backward[value]; ok {
+		delete(b.backward, value)
+		delete(b.forward, k)
+	}
+}
+
+// Len
+// returns the number of entries
+func (b *BiMap[K, V]) Len() int {
+	return len(b.forward)
+}
+
+// Inverse
+// returns a view of the BiMap with keys and values swapped;
+// the view shares storage and the conflict policy with the original
+func (b *BiMap[K, V]) Inverse() *BiMap[V, K] {
+	return &BiMap[V, K]{forward: b.backward, backward: b.forward, policy: b.policy}
+}
+
+// Keys
+// returns the keys as a new Set
+func (b *BiMap[K, V]) Keys() sets.Set[K] {
+	return ToSet(b.forward)
+}
+
+// Values
+// returns the values as a new Set
+func (b *BiMap[K, V]) Values() sets.Set[V] {
+	return ToSet(b.backward)
+}
@@ -0,0 +1,111 @@
+package maps
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/goiste/generics/sets"
+)
+
+func TestBiMap_Put(t *testing.T) {
+	type entry struct {
+		key   string
+		value int
+	}
+	tests := []struct {
+		name    string
+		policy  ConflictPolicy
+		put     entry
+		err     error
+		forward map[string]int
+	}{
+		{name: "new", policy: ConflictError, put: entry{"c", 3}, forward: map[string]int{"a": 1, "b": 2, "c": 3}},
+		{name: "same entry", policy: ConflictError, put: entry{"a", 1}, forward: map[string]int{"a": 1, "b": 2}},
+		{name: "error on key", policy: ConflictError, put: entry{"a", 3}, err: ErrConflict, forward: map[string]int{"a": 1, "b": 2}},
+		{name: "error on value", policy: ConflictError, put: entry{"c", 1}, err: ErrConflict, forward: map[string]int{"a": 1, "b": 2}},
+		{name: "keep", policy: ConflictKeep, put: entry{"a", 2}, forward: map[string]int{"a": 1, "b": 2}},
+		{name: "overwrite key", policy: ConflictOverwrite, put: entry{"a", 3}, forward: map[string]int{"a": 3, "b": 2}},
+		{name: "overwrite value", policy: ConflictOverwrite, put: entry{"c", 1}, forward: map[string]int{"c": 1, "b": 2}},
+		{name: "overwrite both", policy: ConflictOverwrite, put: entry{"a", 2}, forward: map[string]int{"a": 2}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := MakeBiMap[string, int](tt.policy)
+			_ = b.Put("a", 1)
+			_ = b.Put("b", 2)
+
+			if err := b.Put(tt.put.key, tt.put.value); !errors.Is(err, tt.err) {
+				t.Errorf(errorFormat, err, tt.err)
+			}
+			if !reflect.DeepEqual(b.forward, tt.forward) {
+				t.Errorf(errorFormat, b.forward, tt.forward)
+			}
+			if got := Invert(b.backward); !reflect.DeepEqual(got, tt.forward) {
+				t.Errorf(errorFormat, got, tt.forward)
+			}
+		})
+	}
+}
+
+func TestBiMap_Get(t *testing.T) {
+	b := MakeBiMap[string, int](ConflictError)
+	_ = b.Put("one", 1)
+
+	if v, ok := b.GetByKey("one"); !ok || v != 1 {
+		t.Errorf(errorFormat, v, 1)
+	}
+	if k, ok := b.GetByValue(1); !ok || k != "one" {
+		t.Errorf(errorFormat, k, "one")
+	}
+	if _, ok := b.GetByKey("two"); ok {
+		t.Errorf(errorFormat, ok, false)
+	}
+	if _, ok := b.GetByValue(2); ok {
+		t.Errorf(errorFormat, ok, false)
+	}
+}
+
+func TestBiMap_Delete(t *testing.T) {
+	b := MakeBiMap[string, int](ConflictError)
+	_ = b.Put("one", 1)
+	_ = b.Put("two", 2)
+	_ = b.Put("three", 3)
+
+	b.DeleteByKey("one")
+	b.DeleteByValue(2)
+	b.DeleteByKey("missing")
+	b.DeleteByValue(42)
+
+	if b.Len() != 1 || !b.Keys().Equals(sets.Make("three")) || !b.Values().Equals(sets.Make(3)) {
+		t.Errorf(errorFormat, b.forward, map[string]int{"three": 3})
+	}
+	if err := b.Put("one", 2); err != nil {
+		t.Errorf(errorFormat, err, nil)
+	}
+}
+
+func TestBiMap_Inverse(t *testing.T) {
+	b := MakeBiMap[string, int](ConflictError)
+	_ = b.Put("one", 1)
+
+	inv := b.Inverse()
+	if k, ok := inv.GetByKey(1); !ok || k != "one" {
+		t.Errorf(errorFormat, k, "one")
+	}
+
+	if err := inv.Put(2, "two"); err != nil {
+		t.Fatal(err)
+	}
+	if v, ok := b.GetByKey("two"); !ok || v != 2 {
+		t.Errorf(errorFormat, v, 2)
+	}
+	if err := inv.Put(3, "one"); !errors.Is(err, ErrConflict) {
+		t.Errorf(errorFormat, err, ErrConflict)
+	}
+
+	inv.DeleteByValue("one")
+	if b.Len() != 1 || !inv.Inverse().Keys().Equals(sets.Make("two")) {
+		t.Errorf(errorFormat, b.forward, map[string]int{"two": 2})
+	}
+}
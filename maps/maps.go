@@ -0,0 +1,190 @@
+package maps
+
+import (
+	"sort"
+
+	"github.com/goiste/generics/sets"
+)
+
+// Change
+// represents an old and a new value of a key changed between two maps
+type Change[V any] struct {
+	Old V
+	New V
+}
+
+// Difference
+// represents keys added, removed or changed between two maps
+type Difference[K comparable, V any] struct {
+	Added   map[K]V
+	Removed map[K]V
+	Changed map[K]Change[V]
+}
+
+// Keys
+// returns the map keys in unspecified order
+func Keys[K comparable, V any](m map[K]V) []K {
+	result := make([]K, 0, len(m))
+	for k := range m {
+		result = append(result, k)
+	}
+	return result
+}
+
+// Values
+// returns the map values in unspecified order
+func Values[K comparable, V any](m map[K]V) []V {
+	result := make([]V, 0, len(m))
+	for _, v := range m {
+		result = append(result, v)
+	}
+	return result
+}
+
+// SortedKeys
+// returns the map keys in ascending order
+func SortedKeys[K sets.Ordered, V any](m map[K]V) []K {
+	result := Keys(m)
+	sort.Slice(result, func(i, j int) bool {
+		return result[i] < result[j]
+	})
+	return result
+}
+
+// Filter
+// returns a map containing entries for which the func returns true
+func Filter[K comparable, V any](m map[K]V, f func(key K, value V) bool) map[K]V {
+	if f == nil {
+		return m
+	}
+
+	result := make(map[K]V)
+	for k, v := range m {
+		if f(k, v) {
+			result[k] = v
+		}
+	}
+	return result
+}
+
+// MapValues
+// returns a map with the same keys and the values returned by the func for each entry;
+// returns an empty map if the func is nil
+func MapValues[K comparable, V, U any](m map[K]V, f func(key K, value V) U) map[K]U {
+	if f == nil {
+		return map[K]U{}
+	}
+
+	result := make(map[K]U, len(m))
+	for k, v := range m {
+		result[k] = f(k, v)
+	}
+	return result
+}
+
+// MapKeys
+// returns a map with the keys returned by the func for each entry;
+// if the func returns the same key for several entries, one of their values is kept arbitrarily;
+// returns an empty map if the func is nil
+func MapKeys[K, J comparable, V any](m map[K]V, f func(key K, value V) J) map[J]V {
+	if f == nil {
+		return map[J]V{}
+	}
+
+	result := make(map[J]V, len(m))
+	for k, v := range m {
+		result[f(k, v)] = v
+	}
+	return result
+}
+
+// Invert
+// returns a map with keys and values swapped;
+// if several keys have the same value, one of them is kept arbitrarily
+func Invert[K, V comparable](m map[K]V) map[V]K {
+	result := make(map[V]K, len(m))
+	for k, v := range m {
+		result[v] = k
+	}
+	return result
+}
+
+// Merge
+// returns a new map containing the entries of all maps; when a key is present in several maps
+// the resolver is called with the accumulated and the next value and its result is kept;
+// a nil resolver keeps the value from the last map
+func Merge[K comparable, V any](resolve func(key K, prev, next V) V, ms ...map[K]V) map[K]V {
+	size := 0
+	for _, m := range ms {
+		size += len(m)
+	}
+
+	result := make(map[K]V, size)
+	for _, m := range ms {
+		for k, v := range m {
+			if prev, exists := result[k]; exists && resolve != nil {
+				v = resolve(k, prev, v)
+			}
+			result[k] = v
+		}
+	}
+	return result
+}
+
+// Diff
+// returns keys added to, removed from or changed in `to` compared with `from`
+func Diff[K, V comparable](from, to map[K]V) Difference[K, V] {
+	return DiffFunc(from, to, func(a, b V) bool { return a == b })
+}
+
+// DiffFunc
+// returns keys added to, removed from or changed in `to` compared with `from`,
+// comparing values with the func
+func DiffFunc[K comparable, V any](from, to map[K]V, equal func(a, b V) bool) Difference[K, V] {
+	d := Difference[K, V]{
+		Added:   map[K]V{},
+		Removed: map[K]V{},
+		Changed: map[K]Change[V]{},
+	}
+	for k, old := range from {
+		v, exists := to[k]
+		switch {
+		case !exists:
+			d.Removed[k] = old
+		case !equal(old, v):
+			d.Changed[k] = Change[V]{Old: old, New: v}
+		}
+	}
+	for k, v := range to {
+		if _, exists := from[k]; !exists {
+			d.Added[k] = v
+		}
+	}
+	return d
+}
+
+// GroupBy
+// returns a map of slice elements grouped by the key returned by the func, preserving their order;
+// returns an empty map if the func is nil
+func GroupBy[K comparable, T any](s []T, key func(value T) K) map[K][]T {
+	result := map[K][]T{}
+	if key == nil {
+		return result
+	}
+
+	for i := range s {
+		k := key(s[i])
+		result[k] = append(result[k], s[i])
+	}
+	return result
+}
+
+// ToSet
+// returns the map keys as a Set
+func ToSet[K comparable, V any](m map[K]V) sets.Set[K] {
+	result := make(sets.Set[K], len(m))
+	for k := range m {
+		result[k] = struct{}{}
+	}
+	return result
+}
@@ -0,0 +1,185 @@
+package maps
+
+import (
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/goiste/generics/sets"
+)
+
+const errorFormat = "\ngot: %+v\nexp: %+v\n"
+
+var numbers = map[string]int{"one": 1, "two": 2, "three": 3, "four": 4}
+
+func TestKeys(t *testing.T) {
+	tests := []struct {
+		name  string
+		input map[string]int
+		exp   []string
+	}{
+		{name: "nil", input: nil, exp: []string{}},
+		{name: "numbers", input: numbers, exp: []string{"four", "one", "three", "two"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Keys(tt.input)
+			sort.Strings(got)
+			if !reflect.DeepEqual(got, tt.exp) {
+				t.Errorf(errorFormat, got, tt.exp)
+			}
+		})
+	}
+}
+
+func TestValues(t *testing.T) {
+	got := Values(numbers)
+	sort.Ints(got)
+	if exp := []int{1, 2, 3, 4}; !reflect.DeepEqual(got, exp) {
+		t.Errorf(errorFormat, got, exp)
+	}
+}
+
+func TestSortedKeys(t *testing.T) {
+	got := SortedKeys(map[float64]bool{2.5: true, -1: false, 0: true})
+	if exp := []float64{-1, 0, 2.5}; !reflect.DeepEqual(got, exp) {
+		t.Errorf(errorFormat, got, exp)
+	}
+}
+
+func TestFilter(t *testing.T) {
+	tests := []struct {
+		name string
+		f    func(string, int) bool
+		exp  map[string]int
+	}{
+		{name: "nil func", f: nil, exp: numbers},
+		{name: "even", f: func(_ string, v int) bool { return v%2 == 0 }, exp: map[string]int{"two": 2, "four": 4}},
+		{name: "by key", f: func(k string, _ int) bool { return strings.HasPrefix(k, "t") }, exp: map[string]int{"two": 2, "three": 3}},
+		{name: "none", f: func(string, int) bool { return false }, exp: map[string]int{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Filter(numbers, tt.f); !reflect.DeepEqual(got, tt.exp) {
+				t.Errorf(errorFormat, got, tt.exp)
+			}
+		})
+	}
+}
+
+func TestMapValues(t *testing.T) {
+	if got := MapValues[string, int, string](numbers, nil); !reflect.DeepEqual(got, map[string]string{}) {
+		t.Errorf(errorFormat, got, map[string]string{})
+	}
+
+	got := MapValues(numbers, func(k string, v int) string { return strings.Repeat(k[:1], v) })
+	exp := map[string]string{"one": "o", "two": "tt", "three": "ttt", "four": "ffff"}
+	if !reflect.DeepEqual(got, exp) {
+		t.Errorf(errorFormat, got, exp)
+	}
+}
+
+func TestMapKeys(t *testing.T) {
+	if got := MapKeys[string, string, int](numbers, nil); !reflect.DeepEqual(got, map[string]int{}) {
+		t.Errorf(errorFormat, got, map[string]int{})
+	}
+
+	got := MapKeys(numbers, func(k string, _ int) string { return strings.ToUpper(k) })
+	exp := map[string]int{"ONE": 1, "TWO": 2, "THREE": 3, "FOUR": 4}
+	if !reflect.DeepEqual(got, exp) {
+		t.Errorf(errorFormat, got, exp)
+	}
+}
+
+func TestInvert(t *testing.T) {
+	got := Invert(numbers)
+	exp := map[int]string{1: "one", 2: "two", 3: "three", 4: "four"}
+	if !reflect.DeepEqual(got, exp) {
+		t.Errorf(errorFormat, got, exp)
+	}
+}
+
+func TestMerge(t *testing.T) {
+	a := map[string]int{"a": 1, "b": 2}
+	b := map[string]int{"b": 10, "c": 3}
+	c := map[string]int{"b": 100}
+	sum := func(_ string, prev, next int) int { return prev + next }
+
+	tests := []struct {
+		name    string
+		resolve func(string, int, int) int
+		input   []map[string]int
+		exp     map[string]int
+	}{
+		{name: "empty", input: nil, exp: map[string]int{}},
+		{name: "last wins", input: []map[string]int{a, b, c}, exp: map[string]int{"a": 1, "b": 100, "c": 3}},
+		{name: "sum", resolve: sum, input: []map[string]int{a, b, c}, exp: map[string]int{"a": 1, "b": 112, "c": 3}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Merge(tt.resolve, tt.input...); !reflect.DeepEqual(got, tt.exp) {
+				t.Errorf(errorFormat, got, tt.exp)
+			}
+		})
+	}
+
+	if exp := map[string]int{"a": 1, "b": 2}; !reflect.DeepEqual(a, exp) {
+		t.Errorf(errorFormat, a, exp)
+	}
+}
+
+func TestDiff(t *testing.T) {
+	from := map[string]int{"kept": 1, "changed": 2, "removed": 3}
+	to := map[string]int{"kept": 1, "changed": 20, "added": 4}
+
+	got := Diff(from, to)
+	exp := Difference[string, int]{
+		Added:   map[string]int{"added": 4},
+		Removed: map[string]int{"removed": 3},
+		Changed: map[string]Change[int]{"changed": {Old: 2, New: 20}},
+	}
+	if !reflect.DeepEqual(got, exp) {
+		t.Errorf(errorFormat, got, exp)
+	}
+
+	same := Diff(from, from)
+	if len(same.Added)+len(same.Removed)+len(same.Changed) != 0 {
+		t.Errorf(errorFormat, same, Difference[string, int]{})
+	}
+}
+
+func TestDiffFunc(t *testing.T) {
+	from := map[string][]int{"a": {1, 2}, "b": {3}}
+	to := map[string][]int{"a": {1, 2}, "b": {3, 4}}
+
+	got := DiffFunc(from, to, func(x, y []int) bool { return reflect.DeepEqual(x, y) })
+	exp := map[string]Change[[]int]{"b": {Old: []int{3}, New: []int{3, 4}}}
+	if !reflect.DeepEqual(got.Changed, exp) || len(got.Added) != 0 || len(got.Removed) != 0 {
+		t.Errorf(errorFormat, got, exp)
+	}
+}
+
+func TestGroupBy(t *testing.T) {
+	if got := GroupBy[byte]([]string{"apple"}, nil); !reflect.DeepEqual(got, map[byte][]string{}) {
+		t.Errorf(errorFormat, got, map[byte][]string{})
+	}
+
+	words := []string{"apple", "bob", "avocado", "cat", "banana"}
+	got := GroupBy(words, func(w string) byte { return w[0] })
+	exp := map[byte][]string{
+		'a': {"apple", "avocado"},
+		'b': {"bob", "banana"},
+		'c': {"cat"},
+	}
+	if !reflect.DeepEqual(got, exp) {
+		t.Errorf(errorFormat, got, exp)
+	}
+}
+
+func TestToSet(t *testing.T) {
+	got := ToSet(numbers)
+	if exp := sets.Make("one", "two", "three", "four"); !got.Equals(exp) {
+		t.Errorf(errorFormat, got, exp)
+	}
+}
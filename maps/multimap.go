@@ -0,0 +1,184 @@
+package maps
+
+import "github.com/goiste/generics/sets"
+
+// MultiMap represents a mapping from keys of type K to sets of values of type V.
+// Keys without values are never stored.
+type MultiMap[K, V comparable] map[K]sets.Set[V]
+
+// Entry represents a single key/value pair of a MultiMap
+type Entry[K, V any] struct {
+	Key   K
+	Value V
+}
+
+// SetView represents a read-only view of a Set
+type SetView[T comparable] struct {
+	set sets.Set[T]
+}
+
+// MakeMultiMap
+// creates a new empty MultiMap
+func MakeMultiMap[K, V comparable]() MultiMap[K, V] {
+	return make(MultiMap[K, V])
+}
+
+// Put
+// adds the value to the key
+func (m MultiMap[K, V]) Put(key K, value V) {
+	m.PutAll(key, value)
+}
+
+// PutAll
+// adds all the values to the key
+func (m MultiMap[K, V]) PutAll(key K, values ...V) {
+	if len(values) == 0 {
+		return
+	}
+	s, ok := m[key]
+	if !ok {
+		s = make(sets.Set[V], len(values))
+		m[key] = s
+	}
+	s.Add(values...)
+}
+
+// Remove
+// removes the value from the key; the key is deleted when its last value is removed
+func (m MultiMap[K, V]) Remove(key K, value V) {
+	s, ok := m[key]
+	if !ok {
+		return
+	}
+	s.Delete(value)
+	if s.Len() == 0 {
+		delete(m, key)
+	}
+}
+
+// RemoveAll
+// deletes the key and returns its values, or nil if the key is absent
+func (m MultiMap[K, V]) RemoveAll(key K) sets.Set[V] {
+	s := m[key]
+	delete(m, key)
+	return s
+}
+
+// Get
+// returns a read-only view of the values of the key; the view is empty if the key is absent
+func (m MultiMap[K, V]) Get(key K) SetView[V] {
+	return SetView[V]{set: m[key]}
+}
+
+// Has
+// returns true if the MultiMap contains the key or false if not
+func (m MultiMap[K, V]) Has(key K) bool {
+	_, ok := m[key]
+	return ok
+}
+
+// ContainsEntry
+// returns true if the value belongs to the key or false if not
+func (m MultiMap[K, V]) ContainsEntry(key K, value V) bool {
+	return m[key].Has(value)
+}
+
+// Len
+// returns the number of keys
+func (m MultiMap[K, V]) Len() int {
+	return len(m)
+}
+
+// Total
+// returns the number of key/value pairs
+func (m MultiMap[K, V]) Total() int {
+	n := 0
+	for _, s := range m {
+		n += s.Len()
+	}
+	return n
+}
+
+// Keys
+// returns the keys as a new Set
+func (m MultiMap[K, V]) Keys() sets.Set[K] {
+	return ToSet(m)
+}
+
+// Invert
+// returns a new MultiMap mapping each value to the keys it belongs to
+func (m MultiMap[K, V]) Invert() MultiMap[V, K] {
+	result := make(MultiMap[V, K], len(m))
+	for k, s := range m {
+		for v := range s {
+			result.Put(v, k)
+		}
+	}
+	return result
+}
+
+// Entries
+// returns all key/value pairs in unspecified order
+func (m MultiMap[K, V]) Entries() []Entry[K, V] {
+	result := make([]Entry[K, V], 0, m.Total())
+	for k, s := range m {
+		for v := range s {
+			result = append(result, Entry[K, V]{Key: k, Value: v})
+		}
+	}
+	return result
+}
+
+// Pairs
+// returns all key/value pairs as two slices of equal length in unspecified order
+func (m MultiMap[K, V]) Pairs() ([]K, []V) {
+	total := m.Total()
+	keys, values := make([]K, 0, total), make([]V, 0, total)
+	for k, s := range m {
+		for v := range s {
+			keys = append(keys, k)
+			values = append(values, v)
+		}
+	}
+	return keys, values
+}
+
+// Copy
+// returns a deep copy of the MultiMap
+func (m MultiMap[K, V]) Copy() MultiMap[K, V] {
+	result := make(MultiMap[K, V], len(m))
+	for k, s := range m {
+		result[k] = s.Copy()
+	}
+	return result
+}
+
+// Has
+// returns true if the view contains the value or false if not
+func (v SetView[T]) Has(value T) bool {
+	return v.set.Has(value)
+}
+
+// Len
+// returns the number of values in the view
+func (v SetView[T]) Len() int {
+	return len(v.set)
+}
+
+// Values
+// returns the values of the view
+func (v SetView[T]) Values() []T {
+	return v.set.Values()
+}
+
+// Each
+// calls the func for each value until the func returns false
+func (v SetView[T]) Each(f func(T) bool) {
+	v.set.Each(f)
+}
+
+// Copy
+// returns the values of the view as a new Set
+func (v SetView[T]) Copy() sets.Set[T] {
+	return v.set.Copy()
+}
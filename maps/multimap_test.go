@@ -0,0 +1,147 @@
+package maps
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/goiste/generics/sets"
+)
+
+func roles() MultiMap[string, string] {
+	m := MakeMultiMap[string, string]()
+	m.PutAll("alice", "admin", "dev")
+	m.Put("bob", "dev")
+	m.Put("bob", "dev")
+	m.PutAll("carol")
+	return m
+}
+
+func TestMultiMap_Put(t *testing.T) {
+	m := roles()
+	exp := MultiMap[string, string]{
+		"alice": sets.Make("admin", "dev"),
+		"bob":   sets.Make("dev"),
+	}
+	if !reflect.DeepEqual(m, exp) {
+		t.Errorf(errorFormat, m, exp)
+	}
+	if m.Len() != 2 || m.Total() != 3 {
+		t.Errorf(errorFormat, []int{m.Len(), m.Total()}, []int{2, 3})
+	}
+}
+
+func TestMultiMap_Remove(t *testing.T) {
+	m := roles()
+	m.Remove("alice", "admin")
+	m.Remove("bob", "dev")
+	m.Remove("carol", "dev")
+
+	exp := MultiMap[string, string]{"alice": sets.Make("dev")}
+	if !reflect.DeepEqual(m, exp) {
+		t.Errorf(errorFormat, m, exp)
+	}
+
+	removed := m.RemoveAll("alice")
+	if !removed.Equals(sets.Make("dev")) || m.Len() != 0 {
+		t.Errorf(errorFormat, removed, sets.Make("dev"))
+	}
+	if removed = m.RemoveAll("alice"); removed != nil {
+		t.Errorf(errorFormat, removed, nil)
+	}
+}
+
+func TestMultiMap_Get(t *testing.T) {
+	m := roles()
+
+	view := m.Get("alice")
+	if view.Len() != 2 || !view.Has("admin") || view.Has("ops") {
+		t.Errorf(errorFormat, view.Values(), []string{"admin", "dev"})
+	}
+
+	cp := view.Copy()
+	cp.Add("ops")
+	if m.ContainsEntry("alice", "ops") {
+		t.Errorf(errorFormat, m["alice"], sets.Make("admin", "dev"))
+	}
+
+	m.Put("alice", "ops")
+	if !view.Has("ops") {
+		t.Errorf(errorFormat, view.Values(), []string{"admin", "dev", "ops"})
+	}
+
+	empty := m.Get("nobody")
+	if empty.Len() != 0 || len(empty.Values()) != 0 || empty.Copy() == nil {
+		t.Errorf(errorFormat, empty.Values(), []string{})
+	}
+}
+
+func TestMultiMap_ContainsEntry(t *testing.T) {
+	m := roles()
+	tests := []struct {
+		name  string
+		key   string
+		value string
+		exp   bool
+	}{
+		{name: "present", key: "alice", value: "admin", exp: true},
+		{name: "other key", key: "bob", value: "admin", exp: false},
+		{name: "absent key", key: "carol", value: "dev", exp: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := m.ContainsEntry(tt.key, tt.value); got != tt.exp {
+				t.Errorf(errorFormat, got, tt.exp)
+			}
+		})
+	}
+	if !m.Has("bob") || m.Has("carol") || !m.Keys().Equals(sets.Make("alice", "bob")) {
+		t.Errorf(errorFormat, m.Keys(), sets.Make("alice", "bob"))
+	}
+}
+
+func TestMultiMap_Invert(t *testing.T) {
+	got := roles().Invert()
+	exp := MultiMap[string, string]{
+		"admin": sets.Make("alice"),
+		"dev":   sets.Make("alice", "bob"),
+	}
+	if !reflect.DeepEqual(got, exp) {
+		t.Errorf(errorFormat, got, exp)
+	}
+}
+
+func TestMultiMap_Entries(t *testing.T) {
+	m := roles()
+
+	entries := m.Entries()
+	sort.Slice(entries, func(i, j int) bool {
+		if entries[i].Key != entries[j].Key {
+			return entries[i].Key < entries[j].Key
+		}
+		return entries[i].Value < entries[j].Value
+	})
+	exp := []Entry[string, string]{{"alice", "admin"}, {"alice", "dev"}, {"bob", "dev"}}
+	if !reflect.DeepEqual(entries, exp) {
+		t.Errorf(errorFormat, entries, exp)
+	}
+
+	keys, values := m.Pairs()
+	if len(keys) != len(exp) || len(values) != len(exp) {
+		t.Fatalf(errorFormat, len(keys), len(exp))
+	}
+	for i := range keys {
+		if !m.ContainsEntry(keys[i], values[i]) {
+			t.Errorf(errorFormat, Entry[string, string]{keys[i], values[i]}, exp)
+		}
+	}
+}
+
+func TestMultiMap_Copy(t *testing.T) {
+	m := roles()
+	cp := m.Copy()
+	cp.Put("alice", "ops")
+	if m.ContainsEntry("alice", "ops") || !reflect.DeepEqual(cp.Invert()["admin"], sets.Make("alice")) {
+		t.Errorf(errorFormat, m, roles())
+	}
+}
@@ -0,0 +1,194 @@
+package sets
+
+// Union
+// returns a new Set containing the values of all the Sets
+func Union[T comparable](sets ...Set[T]) Set[T] {
+	largest := 0
+	for i := range sets {
+		if len(sets[i]) > largest {
+			largest = len(sets[i])
+		}
+	}
+
+	result := make(Set[T], largest)
+	for i := range sets {
+		for v := range sets[i] {
+			result[v] = struct{}{}
+		}
+	}
+	return result
+}
+
+// Difference
+// returns a new Set containing the values of `s` that are not represented in any of the other Sets
+func Difference[T comparable](s Set[T], others ...Set[T]) Set[T] {
+	result := make(Set[T], len(s))
+	for v := range s {
+		if !hasAny(others, v) {
+			result[v] = struct{}{}
+		}
+	}
+	return result
+}
+
+// Intersection
+// returns a new Set containing the values represented in each of the Sets;
+// the smallest Set is iterated and the rest are only probed
+func Intersection[T comparable](sets ...Set[T]) Set[T] {
+	if len(sets) == 0 {
+		return Make[T]()
+	}
+
+	smallest := 0
+	for i := range sets {
+		if len(sets[i]) < len(sets[smallest]) {
+			smallest = i
+		}
+	}
+
+	result := make(Set[T], len(sets[smallest]))
+	for v := range sets[smallest] {
+		in := true
+		for i := range sets {
+			if i == smallest {
+				continue
+			}
+			if _, e := sets[i][v]; !e {
+				in = false
+				break
+			}
+		}
+		if in {
+			result[v] = struct{}{}
+		}
+	}
+	return result
+}
+
+// SymmetricDifference
+// returns a new Set containing the values represented in exactly one of the two Sets
+func SymmetricDifference[T comparable](a, b Set[T]) Set[T] {
+	result := make(Set[T])
+	for v := range a {
+		if _, e := b[v]; !e {
+			result[v] = struct{}{}
+		}
+	}
+	for v := range b {
+		if _, e := a[v]; !e {
+			result[v] = struct{}{}
+		}
+	}
+	return result
+}
+
+// Filtered
+// returns a new Set containing the values for which the func returns true
+func Filtered[T comparable](s Set[T], f func(T) bool) Set[T] {
+	if f == nil {
+		return s.Copy()
+	}
+
+	result := make(Set[T])
+	for v := range s {
+		if f(v) {
+			result[v] = struct{}{}
+		}
+	}
+	return result
+}
+
+// Mapped
+// returns a new Set filled with the values returned by the func for each element
+func Mapped[T comparable](s Set[T], f func(T) T) Set[T] {
+	if f == nil {
+		return s.Copy()
+	}
+
+	result := make(Set[T], len(s))
+	for v := range s {
+		result[f(v)] = struct{}{}
+	}
+	return result
+}
+
+// MapTo
+// returns a new Set filled with the values of type U returned by the func for each element;
+// returns an empty Set if the func is nil
+func MapTo[T, U comparable](s Set[T], f func(T) U) Set[U] {
+	if f == nil {
+		return Make[U]()
+	}
+
+	result := make(Set[U], len(s))
+	for v := range s {
+		result[f(v)] = struct{}{}
+	}
+	return result
+}
+
+// FlatMap
+// returns a new Set containing the union of the Sets returned by the func for each element;
+// returns an empty Set if the func is nil
+func FlatMap[T, U comparable](s Set[T], f func(T) Set[U]) Set[U] {
+	result := make(Set[U])
+	if f == nil {
+		return result
+	}
+
+	for v := range s {
+		for u := range f(v) {
+			result[u] = struct{}{}
+		}
+	}
+	return result
+}
+
+// Partition
+// returns two new Sets: the values for which the func returns true and the rest;
+// all values match if the func is nil
+func Partition[T comparable](s Set[T], f func(T) bool) (matching, rest Set[T]) {
+	if f == nil {
+		return s.Copy(), Make[T]()
+	}
+
+	matching, rest = make(Set[T]), make(Set[T])
+	for v := range s {
+		if f(v) {
+			matching[v] = struct{}{}
+		} else {
+			rest[v] = struct{}{}
+		}
+	}
+	return matching, rest
+}
+
+// GroupBy
+// returns the values grouped into new Sets by the key returned by the func;
+// returns an empty map if the func is nil
+func GroupBy[T, K comparable](s Set[T], key func(T) K) map[K]Set[T] {
+	result := map[K]Set[T]{}
+	if key == nil {
+		return result
+	}
+
+	for v := range s {
+		k := key(v)
+		group, ok := result[k]
+		if !ok {
+			group = make(Set[T])
+			result[k] = group
+		}
+		group[v] = struct{}{}
+	}
+	return result
+}
+
+func hasAny[T comparable](sets []Set[T], value T) bool {
+	for i := range sets {
+		if _, e := sets[i][value]; e {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,248 @@
+package sets
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func TestUnion(t *testing.T) {
+	tests := []struct {
+		name string
+		sets []Set[int]
+		exp  Set[int]
+	}{
+		{name: "empty", exp: Set[int]{}},
+		{name: "nil", sets: []Set[int]{nil, Make[int](1)}, exp: Make[int](1)},
+		{name: "123", sets: []Set[int]{Make[int](1, 2), Make[int](2, 3, 4), Make[int](3, 4, 5)}, exp: intSet},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			before := copySets(tt.sets)
+			if got := Union(tt.sets...); !reflect.DeepEqual(got, tt.exp) {
+				t.Errorf(errorFormat, got, tt.exp)
+			}
+			if !reflect.DeepEqual(tt.sets, before) {
+				t.Errorf(errorFormat, tt.sets, before)
+			}
+		})
+	}
+}
+
+func TestDifference(t *testing.T) {
+	tests := []struct {
+		name   string
+		set    Set[int]
+		others []Set[int]
+		exp    Set[int]
+	}{
+		{name: "empty", exp: Set[int]{}},
+		{name: "empty_others", set: Make[int](1, 2, 3), exp: Make[int](1, 2, 3)},
+		{name: "123", set: intSet, others: []Set[int]{Make[int](4), Make[int](5, 6)}, exp: Make[int](1, 2, 3)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			before := copySets(append([]Set[int]{tt.set}, tt.others...))
+			if got := Difference(tt.set, tt.others...); !reflect.DeepEqual(got, tt.exp) {
+				t.Errorf(errorFormat, got, tt.exp)
+			}
+			if after := append([]Set[int]{tt.set}, tt.others...); !reflect.DeepEqual(after, before) {
+				t.Errorf(errorFormat, after, before)
+			}
+		})
+	}
+}
+
+func TestIntersection(t *testing.T) {
+	tests := []struct {
+		name string
+		sets []Set[int]
+		exp  Set[int]
+	}{
+		{name: "empty", exp: Set[int]{}},
+		{name: "single", sets: []Set[int]{Make[int](1, 2)}, exp: Make[int](1, 2)},
+		{name: "with_empty", sets: []Set[int]{Make[int](1, 2), Make[int]()}, exp: Set[int]{}},
+		{name: "123", sets: []Set[int]{intSet, Make[int](1, 2, 3, 4), Make[int](1, 2, 3, 5, 6)}, exp: Make[int](1, 2, 3)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			before := copySets(tt.sets)
+			if got := Intersection(tt.sets...); !reflect.DeepEqual(got, tt.exp) {
+				t.Errorf(errorFormat, got, tt.exp)
+			}
+			if !reflect.DeepEqual(tt.sets, before) {
+				t.Errorf(errorFormat, tt.sets, before)
+			}
+		})
+	}
+}
+
+func TestSymmetricDifference(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b Set[int]
+		exp  Set[int]
+	}{
+		{name: "empty", exp: Set[int]{}},
+		{name: "equal", a: Make[int](1, 2), b: Make[int](1, 2), exp: Set[int]{}},
+		{name: "overlap", a: Make[int](1, 2, 3), b: Make[int](3, 4), exp: Make[int](1, 2, 4)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := SymmetricDifference(tt.a, tt.b); !reflect.DeepEqual(got, tt.exp) {
+				t.Errorf(errorFormat, got, tt.exp)
+			}
+		})
+	}
+}
+
+func TestFiltered(t *testing.T) {
+	tests := []struct {
+		name string
+		f    func(int) bool
+		exp  Set[int]
+	}{
+		{name: "nil_func", exp: intSet},
+		{name: "123", f: func(i int) bool { return i < 4 }, exp: Make[int](1, 2, 3)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := Make[int](1, 2, 3, 4, 5)
+			if got := Filtered(s, tt.f); !reflect.DeepEqual(got, tt.exp) {
+				t.Errorf(errorFormat, got, tt.exp)
+			}
+			if !reflect.DeepEqual(s, intSet) {
+				t.Errorf(errorFormat, s, intSet)
+			}
+		})
+	}
+}
+
+func TestMapped(t *testing.T) {
+	tests := []struct {
+		name string
+		f    func(int) int
+		exp  Set[int]
+	}{
+		{name: "nil_func", exp: Make[int](1, 2, 3)},
+		{name: "+1", f: func(i int) int { return i + 1 }, exp: Make[int](2, 3, 4)},
+		{name: "collapse", f: func(i int) int { return i / 2 }, exp: Make[int](0, 1)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := Make[int](1, 2, 3)
+			if got := Mapped(s, tt.f); !reflect.DeepEqual(got, tt.exp) {
+				t.Errorf(errorFormat, got, tt.exp)
+			}
+			if exp := Make[int](1, 2, 3); !reflect.DeepEqual(s, exp) {
+				t.Errorf(errorFormat, s, exp)
+			}
+		})
+	}
+}
+
+func TestMapTo(t *testing.T) {
+	tests := []struct {
+		name string
+		f    func(int) string
+		exp  Set[string]
+	}{
+		{name: "nil_func", exp: Set[string]{}},
+		{name: "format", f: func(i int) string { return fmt.Sprintf("#%d", i) }, exp: Make[string]("#1", "#2", "#3")},
+		{name: "collapse", f: func(i int) string { return fmt.Sprint(i%2 == 0) }, exp: Make[string]("true", "false")},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := Make[int](1, 2, 3)
+			if got := MapTo(s, tt.f); !reflect.DeepEqual(got, tt.exp) {
+				t.Errorf(errorFormat, got, tt.exp)
+			}
+		})
+	}
+}
+
+func TestFlatMap(t *testing.T) {
+	tests := []struct {
+		name string
+		f    func(int) Set[int]
+		exp  Set[int]
+	}{
+		{name: "nil_func", exp: Set[int]{}},
+		{name: "divisors", f: func(i int) Set[int] {
+			d := Make[int]()
+			for j := 1; j <= i; j++ {
+				if i%j == 0 {
+					d.Add(j)
+				}
+			}
+			return d
+		}, exp: Make[int](1, 2, 3, 4)},
+		{name: "nil_sets", f: func(int) Set[int] { return nil }, exp: Set[int]{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := FlatMap(Make[int](3, 4), tt.f); !reflect.DeepEqual(got, tt.exp) {
+				t.Errorf(errorFormat, got, tt.exp)
+			}
+		})
+	}
+}
+
+func TestPartition(t *testing.T) {
+	tests := []struct {
+		name     string
+		f        func(int) bool
+		matching Set[int]
+		rest     Set[int]
+	}{
+		{name: "nil_func", matching: intSet, rest: Set[int]{}},
+		{name: "even", f: func(i int) bool { return i%2 == 0 }, matching: Make[int](2, 4), rest: Make[int](1, 3, 5)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := Make[int](1, 2, 3, 4, 5)
+			matching, rest := Partition(s, tt.f)
+			if !reflect.DeepEqual(matching, tt.matching) || !reflect.DeepEqual(rest, tt.rest) {
+				t.Errorf(errorFormat, []Set[int]{matching, rest}, []Set[int]{tt.matching, tt.rest})
+			}
+			if !reflect.DeepEqual(s, intSet) {
+				t.Errorf(errorFormat, s, intSet)
+			}
+		})
+	}
+}
+
+func TestGroupBy(t *testing.T) {
+	tests := []struct {
+		name string
+		key  func(string) int
+		exp  map[int]Set[string]
+	}{
+		{name: "nil_func", exp: map[int]Set[string]{}},
+		{name: "length", key: func(s string) int { return len(s) }, exp: map[int]Set[string]{
+			3: Make[string]("one", "two"),
+			4: Make[string]("four", "five"),
+			5: Make[string]("three"),
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := GroupBy(Make[string]("one", "two", "three", "four", "five"), tt.key); !reflect.DeepEqual(got, tt.exp) {
+				t.Errorf(errorFormat, got, tt.exp)
+			}
+		})
+	}
+}
+
+func copySets[T comparable](sets []Set[T]) []Set[T] {
+	if sets == nil {
+		return nil
+	}
+	result := make([]Set[T], len(sets))
+	for i := range sets {
+		if sets[i] != nil {
+			result[i] = sets[i].Copy()
+		}
+	}
+	return result
+}
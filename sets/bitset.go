@@ -0,0 +1,290 @@
+package sets
+
+import (
+	"encoding/binary"
+	"errors"
+	"math/bits"
+)
+
+const bitSetVersion = 1
+
+// ErrInvalidBitSet is returned when decoding malformed BitSet data
+var ErrInvalidBitSet = errors.New("sets: invalid BitSet data")
+
+// BitSet represents a set of non-negative integers stored as a bit array.
+// Memory use is proportional to the largest element, so it suits dense sets of small integers.
+// The zero value is an empty set.
+type BitSet struct {
+	words []uint64
+}
+
+// MakeBitSet
+// creates a new BitSet containing the values
+func MakeBitSet(values ...int) *BitSet {
+	b := &BitSet{}
+	b.Add(values...)
+	return b
+}
+
+// Add
+// adds values to the BitSet; panics if a value is negative
+func (b *BitSet) Add(values ...int) {
+	for _, v := range values {
+		if v < 0 {
+			panic("sets: negative value in BitSet.Add")
+		}
+		w := v >> 6
+		if w >= len(b.words) {
+			b.grow(w + 1)
+		}
+		b.words[w] |= 1 << (uint(v) & 63)
+	}
+}
+
+// Delete
+// deletes values from the BitSet
+func (b *BitSet) Delete(values ...int) {
+	for _, v := range values {
+		if v < 0 || v>>6 >= len(b.words) {
+			continue
+		}
+		b.words[v>>6] &^= 1 << (uint(v) & 63)
+	}
+}
+
+// Truncate
+// deletes all values from the BitSet
+func (b *BitSet) Truncate() {
+	b.words = nil
+}
+
+// Has
+// returns true if BitSet contains the value or false if not
+func (b *BitSet) Has(value int) bool {
+	if value < 0 || value>>6 >= len(b.words) {
+		return false
+	}
+	return b.words[value>>6]&(1<<(uint(value)&63)) != 0
+}
+
+// Len
+// returns the number of values in the BitSet
+func (b *BitSet) Len() int {
+	n := 0
+	for _, w := range b.words {
+		n += bits.OnesCount64(w)
+	}
+	return n
+}
+
+// Values
+// returns the BitSet values in ascending order
+func (b *BitSet) Values() []int {
+	values := make([]int, 0, b.Len())
+	b.Each(func(v int) bool {
+		values = append(values, v)
+		return true
+	})
+	return values
+}
+
+// Each
+// calls the func for each value in ascending order until the func returns false
+func (b *BitSet) Each(f func(int) bool) {
+	for i, w := range b.words {
+		for w != 0 {
+			if !f(i<<6 + bits.TrailingZeros64(w)) {
+				return
+			}
+			w &= w - 1
+		}
+	}
+}
+
+// NextSet
+// returns the smallest value greater than or equal to `from`, and false if there is none
+func (b *BitSet) NextSet(from int) (int, bool) {
+	if from < 0 {
+		from = 0
+	}
+	i := from >> 6
+	if i >= len(b.words) {
+		return 0, false
+	}
+	w := b.words[i] >> (uint(from) & 63)
+	if w != 0 {
+		return from + bits.TrailingZeros64(w), true
+	}
+	for i++; i < len(b.words); i++ {
+		if b.words[i] != 0 {
+			return i<<6 + bits.TrailingZeros64(b.words[i]), true
+		}
+	}
+	return 0, false
+}
+
+// PrevSet
+// returns the largest value less than or equal to `from`, and false if there is none
+func (b *BitSet) PrevSet(from int) (int, bool) {
+	if from < 0 {
+		return 0, false
+	}
+	i := from >> 6
+	if i >= len(b.words) {
+		i = len(b.words) - 1
+		from = i<<6 + 63
+	}
+	if i < 0 {
+		return 0, false
+	}
+	w := b.words[i] << (63 - uint(from)&63)
+	if w != 0 {
+		return from - bits.LeadingZeros64(w), true
+	}
+	for i--; i >= 0; i-- {
+		if b.words[i] != 0 {
+			return i<<6 + 63 - bits.LeadingZeros64(b.words[i]), true
+		}
+	}
+	return 0, false
+}
+
+// Merge
+// adds values of the other BitSets
+func (b *BitSet) Merge(others ...*BitSet) {
+	for _, o := range others {
+		if len(o.words) > len(b.words) {
+			b.grow(len(o.words))
+		}
+		for i, w := range o.words {
+			b.words[i] |= w
+		}
+	}
+}
+
+// Diff
+// removes all values represented in any of the other BitSets
+func (b *BitSet) Diff(others ...*BitSet) {
+	for _, o := range others {
+		n := len(o.words)
+		if n > len(b.words) {
+			n = len(b.words)
+		}
+		for i := 0; i < n; i++ {
+			b.words[i] &^= o.words[i]
+		}
+	}
+}
+
+// Intersect
+// removes all values not represented in all others BitSets
+func (b *BitSet) Intersect(others ...*BitSet) {
+	if len(others) == 0 {
+		b.Truncate()
+		return
+	}
+
+	for _, o := range others {
+		if len(o.words) < len(b.words) {
+			b.words = b.words[:len(o.words)]
+		}
+		for i := range b.words {
+			b.words[i] &= o.words[i]
+		}
+	}
+}
+
+// Equals
+// returns true if the BitSets are equal to each other
+func (b *BitSet) Equals(other *BitSet) bool {
+	short, long := b.words, other.words
+	if len(short) > len(long) {
+		short, long = long, short
+	}
+	for i := range short {
+		if short[i] != long[i] {
+			return false
+		}
+	}
+	for _, w := range long[len(short):] {
+		if w != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// Copy
+// returns a copy of the BitSet
+func (b *BitSet) Copy() *BitSet {
+	words := make([]uint64, len(b.words))
+	copy(words, b.words)
+	return &BitSet{words: words}
+}
+
+// ToSet
+// returns the BitSet values as a Set
+func (b *BitSet) ToSet() Set[int] {
+	s := make(Set[int], b.Len())
+	b.Each(func(v int) bool {
+		s[v] = struct{}{}
+		return true
+	})
+	return s
+}
+
+// MarshalBinary
+// implements encoding.BinaryMarshaler: a version byte, the number of words as uvarint
+// and the words in little-endian order, without trailing zero words
+func (b *BitSet) MarshalBinary() ([]byte, error) {
+	n := len(b.words)
+	for n > 0 && b.words[n-1] == 0 {
+		n--
+	}
+
+	data := make([]byte, 1+binary.MaxVarintLen64+8*n)
+	data[0] = bitSetVersion
+	k := 1 + binary.PutUvarint(data[1:], uint64(n))
+	for _, w := range b.words[:n] {
+		binary.LittleEndian.PutUint64(data[k:], w)
+		k += 8
+	}
+	return data[:k], nil
+}
+
+// UnmarshalBinary
+// implements encoding.BinaryUnmarshaler, replacing the BitSet contents
+func (b *BitSet) UnmarshalBinary(data []byte) error {
+	if len(data) == 0 || data[0] != bitSetVersion {
+		return ErrInvalidBitSet
+	}
+	n, k := binary.Uvarint(data[1:])
+	if k <= 0 {
+		return ErrInvalidBitSet
+	}
+	data = data[1+k:]
+	if n > uint64(len(data))/8 || uint64(len(data)) != 8*n {
+		return ErrInvalidBitSet
+	}
+
+	words := make([]uint64, n)
+	for i := range words {
+		words[i] = binary.LittleEndian.Uint64(data[8*i:])
+	}
+	b.words = words
+	return nil
+}
+
+func (b *BitSet) grow(n int) {
+	if n <= cap(b.words) {
+		old := len(b.words)
+		b.words = b.words[:n]
+		for i := old; i < n; i++ {
+			b.words[i] = 0
+		}
+		return
+	}
+	words := make([]uint64, n, n+n/4)
+	copy(words, b.words)
+	b.words = words
+}
@@ -0,0 +1,307 @@
+package sets
+
+import (
+	"errors"
+	"math/rand"
+	"reflect"
+	"sort"
+	"testing"
+)
+
+func TestMakeBitSet(t *testing.T) {
+	tests := []struct {
+		name   string
+		values []int
+		exp    []int
+	}{
+		{name: "empty", exp: []int{}},
+		{name: "values", values: []int{130, 0, 63, 64, 5, 63}, exp: []int{0, 5, 63, 64, 130}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := MakeBitSet(tt.values...)
+			if got := b.Values(); !reflect.DeepEqual(got, tt.exp) {
+				t.Errorf(errorFormat, got, tt.exp)
+			}
+			if got := b.Len(); got != len(tt.exp) {
+				t.Errorf(errorFormat, got, len(tt.exp))
+			}
+		})
+	}
+}
+
+func TestBitSet_AddNegative(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Errorf(errorFormat, "no panic", "panic")
+		}
+	}()
+	MakeBitSet(-1)
+}
+
+func TestBitSet_DeleteHas(t *testing.T) {
+	b := MakeBitSet(1, 2, 3, 100)
+	b.Delete(2, 100, -5, 1000)
+	tests := []struct {
+		val int
+		exp bool
+	}{
+		{val: -1, exp: false},
+		{val: 1, exp: true},
+		{val: 2, exp: false},
+		{val: 3, exp: true},
+		{val: 100, exp: false},
+		{val: 1000, exp: false},
+	}
+	for _, tt := range tests {
+		if got := b.Has(tt.val); got != tt.exp {
+			t.Errorf(errorFormat, got, tt.exp)
+		}
+	}
+}
+
+func TestBitSet_Truncate(t *testing.T) {
+	b := MakeBitSet(1, 2, 3)
+	b.Truncate()
+	if got := b.Len(); got != 0 {
+		t.Errorf(errorFormat, got, 0)
+	}
+}
+
+func TestBitSet_Each(t *testing.T) {
+	got := make([]int, 0)
+	MakeBitSet(70, 3, 9, 200).Each(func(v int) bool {
+		got = append(got, v)
+		return v < 70
+	})
+	if exp := []int{3, 9, 70}; !reflect.DeepEqual(got, exp) {
+		t.Errorf(errorFormat, got, exp)
+	}
+}
+
+func TestBitSet_NextPrevSet(t *testing.T) {
+	b := MakeBitSet(3, 64, 65, 200)
+	tests := []struct {
+		name           string
+		from           int
+		next, prev     int
+		okNext, okPrev bool
+	}{
+		{name: "negative", from: -1, next: 3, okNext: true},
+		{name: "before_first", from: 2, next: 3, okNext: true},
+		{name: "exact", from: 64, next: 64, prev: 64, okNext: true, okPrev: true},
+		{name: "gap", from: 100, next: 200, prev: 65, okNext: true, okPrev: true},
+		{name: "word_start", from: 63, next: 64, prev: 3, okNext: true, okPrev: true},
+		{name: "after_last", from: 201, prev: 200, okPrev: true},
+		{name: "beyond_words", from: 10000, prev: 200, okPrev: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			next, okNext := b.NextSet(tt.from)
+			prev, okPrev := b.PrevSet(tt.from)
+			got := []any{next, okNext, prev, okPrev}
+			exp := []any{tt.next, tt.okNext, tt.prev, tt.okPrev}
+			if !reflect.DeepEqual(got, exp) {
+				t.Errorf(errorFormat, got, exp)
+			}
+		})
+	}
+
+	var empty BitSet
+	if _, ok := empty.PrevSet(10); ok {
+		t.Errorf(errorFormat, ok, false)
+	}
+}
+
+func TestBitSet_Merge(t *testing.T) {
+	b := MakeBitSet(1, 2)
+	b.Merge(MakeBitSet(2, 3, 400), MakeBitSet())
+	if got, exp := b.Values(), []int{1, 2, 3, 400}; !reflect.DeepEqual(got, exp) {
+		t.Errorf(errorFormat, got, exp)
+	}
+}
+
+func TestBitSet_Diff(t *testing.T) {
+	b := MakeBitSet(1, 2, 3, 400)
+	b.Diff(MakeBitSet(2), MakeBitSet(400, 1000))
+	if got, exp := b.Values(), []int{1, 3}; !reflect.DeepEqual(got, exp) {
+		t.Errorf(errorFormat, got, exp)
+	}
+}
+
+func TestBitSet_Intersect(t *testing.T) {
+	tests := []struct {
+		name   string
+		others []*BitSet
+		exp    []int
+	}{
+		{name: "empty_others", exp: []int{}},
+		{name: "intersect", others: []*BitSet{MakeBitSet(1, 2, 3, 400), MakeBitSet(1, 3, 5)}, exp: []int{1, 3}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := MakeBitSet(1, 2, 3, 4, 400)
+			b.Intersect(tt.others...)
+			if got := b.Values(); !reflect.DeepEqual(got, tt.exp) {
+				t.Errorf(errorFormat, got, tt.exp)
+			}
+		})
+	}
+
+	t.Run("regrow", func(t *testing.T) {
+		b := MakeBitSet(1, 400)
+		b.Intersect(MakeBitSet(1))
+		b.Add(200)
+		if got, exp := b.Values(), []int{1, 200}; !reflect.DeepEqual(got, exp) {
+			t.Errorf(errorFormat, got, exp)
+		}
+	})
+}
+
+func TestBitSet_Equals(t *testing.T) {
+	shrunk := MakeBitSet(1, 500)
+	shrunk.Delete(500)
+	tests := []struct {
+		name  string
+		set   *BitSet
+		other *BitSet
+		exp   bool
+	}{
+		{name: "empty", set: MakeBitSet(), other: &BitSet{}, exp: true},
+		{name: "equals", set: MakeBitSet(1, 2), other: MakeBitSet(2, 1), exp: true},
+		{name: "trailing_zeros", set: shrunk, other: MakeBitSet(1), exp: true},
+		{name: "not_equals", set: MakeBitSet(1, 2), other: MakeBitSet(1, 3), exp: false},
+		{name: "not_equals_len", set: MakeBitSet(1), other: MakeBitSet(1, 300), exp: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.set.Equals(tt.other); got != tt.exp {
+				t.Errorf(errorFormat, got, tt.exp)
+			}
+		})
+	}
+}
+
+func TestBitSet_Copy(t *testing.T) {
+	b := MakeBitSet(1, 2)
+	c := b.Copy()
+	b.Add(3)
+	if got, exp := c.Values(), []int{1, 2}; !reflect.DeepEqual(got, exp) {
+		t.Errorf(errorFormat, got, exp)
+	}
+}
+
+func TestBitSet_ToSet(t *testing.T) {
+	if got := MakeBitSet(1, 2, 3, 4, 5).ToSet(); !reflect.DeepEqual(got, intSet) {
+		t.Errorf(errorFormat, got, intSet)
+	}
+}
+
+func TestBitSet_Binary(t *testing.T) {
+	shrunk := MakeBitSet(1, 500)
+	shrunk.Delete(500)
+	tests := []struct {
+		name string
+		set  *BitSet
+	}{
+		{name: "empty", set: MakeBitSet()},
+		{name: "values", set: MakeBitSet(0, 63, 64, 1000)},
+		{name: "trailing_zeros", set: shrunk},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := tt.set.MarshalBinary()
+			if err != nil {
+				t.Fatal(err)
+			}
+			got := MakeBitSet(7)
+			if err = got.UnmarshalBinary(data); err != nil {
+				t.Fatal(err)
+			}
+			if !got.Equals(tt.set) || got.Has(7) != tt.set.Has(7) {
+				t.Errorf(errorFormat, got.Values(), tt.set.Values())
+			}
+		})
+	}
+
+	invalid := [][]byte{nil, {2, 0}, {bitSetVersion}, {bitSetVersion, 1, 0}}
+	for _, data := range invalid {
+		if err := new(BitSet).UnmarshalBinary(data); !errors.Is(err, ErrInvalidBitSet) {
+			t.Errorf(errorFormat, err, ErrInvalidBitSet)
+		}
+	}
+}
+
+func TestBitSet_Random(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	b := MakeBitSet()
+	ref := Make[int]()
+	for i := 0; i < 5000; i++ {
+		v := r.Intn(2000)
+		if r.Intn(3) == 0 {
+			b.Delete(v)
+			ref.Delete(v)
+		} else {
+			b.Add(v)
+			ref.Add(v)
+		}
+	}
+	exp := ref.Values()
+	sort.Ints(exp)
+	if got := b.Values(); !reflect.DeepEqual(got, exp) {
+		t.Errorf(errorFormat, got, exp)
+	}
+}
+
+func benchBitSet(n int) *BitSet {
+	b := &BitSet{}
+	for i := 0; i < n; i++ {
+		b.Add(i)
+	}
+	return b
+}
+
+func BenchmarkBitSet_Add(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		benchBitSet(10_000)
+	}
+}
+
+func BenchmarkSetInt_Add(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		s := Make[int]()
+		for v := 0; v < 10_000; v++ {
+			s.Add(v)
+		}
+	}
+}
+
+func BenchmarkBitSet_Intersect(b *testing.B) {
+	x, y := benchBitSet(100_000), benchBitSet(50_000)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		c := x.Copy()
+		c.Intersect(y)
+	}
+}
+
+func BenchmarkSetInt_Intersect(b *testing.B) {
+	x, y := benchSet(100_000), benchSet(50_000)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		c := x.Copy()
+		c.Intersect(y)
+	}
+}
+
+func BenchmarkBitSet_Len(b *testing.B) {
+	x := benchBitSet(100_000)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		x.Len()
+	}
+}
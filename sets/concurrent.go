@@ -0,0 +1,276 @@
+package sets
+
+import (
+	"sync"
+
+	"github.com/goiste/generics/internal/hashing"
+)
+
+// DefaultShards is the number of shards used by MakeConcurrent
+const DefaultShards = 32
+
+// ConcurrentSet represents a set of elements of type T that is safe for concurrent use.
+// Elements are distributed over independently locked shards to reduce lock contention.
+//
+// Operations are atomic per shard, not across the whole set: a Len or Values call running
+// concurrently with writes observes each shard at a slightly different moment.
+// The zero value is an empty set with a single shard.
+type ConcurrentSet[T comparable] struct {
+	once   sync.Once
+	shards []shard[T]
+}
+
+type shard[T comparable] struct {
+	mu  sync.RWMutex
+	set Set[T]
+}
+
+// MakeConcurrent
+// creates a new ConcurrentSet of type T with DefaultShards shards
+func MakeConcurrent[T comparable](values ...T) *ConcurrentSet[T] {
+	return MakeConcurrentSharded[T](DefaultShards, values...)
+}
+
+// MakeConcurrentSharded
+// creates a new ConcurrentSet of type T with the given number of shards (at least 1)
+func MakeConcurrentSharded[T comparable](shards int, values ...T) *ConcurrentSet[T] {
+	if shards < 1 {
+		shards = 1
+	}
+
+	s := &ConcurrentSet[T]{shards: make([]shard[T], shards)}
+	for i := range s.shards {
+		s.shards[i].set = Make[T]()
+	}
+	s.Add(values...)
+	return s
+}
+
+// getShards returns the shards, creating a single one for a zero-value Set
+func (s *ConcurrentSet[T]) getShards() []shard[T] {
+	s.once.Do(func() {
+		if s.shards == nil {
+			s.shards = []shard[T]{{set: Make[T]()}}
+		}
+	})
+	return s.shards
+}
+
+func shardOf[T comparable](shards []shard[T], value T) *shard[T] {
+	if len(shards) == 1 {
+		return &shards[0]
+	}
+	return &shards[hashing.Sum64(value)%uint64(len(shards))]
+}
+
+// Add
+// adds values to the Set
+func (s *ConcurrentSet[T]) Add(values ...T) {
+	shards := s.getShards()
+	for _, v := range values {
+		sh := shardOf(shards, v)
+		sh.mu.Lock()
+		sh.set[v] = struct{}{}
+		sh.mu.Unlock()
+	}
+}
+
+// Delete
+// deletes values from the Set
+func (s *ConcurrentSet[T]) Delete(values ...T) {
+	shards := s.getShards()
+	for _, v := range values {
+		sh := shardOf(shards, v)
+		sh.mu.Lock()
+		delete(sh.set, v)
+		sh.mu.Unlock()
+	}
+}
+
+// Truncate
+// deletes all values from the Set
+func (s *ConcurrentSet[T]) Truncate() {
+	shards := s.getShards()
+	for i := range shards {
+		sh := &shards[i]
+		sh.mu.Lock()
+		sh.set = Make[T]()
+		sh.mu.Unlock()
+	}
+}
+
+// Has
+// returns true if Set contains the value or false if not
+func (s *ConcurrentSet[T]) Has(value T) bool {
+	shards := s.getShards()
+	sh := shardOf(shards, value)
+	sh.mu.RLock()
+	_, e := sh.set[value]
+	sh.mu.RUnlock()
+	return e
+}
+
+// Len
+// returns the length of the Set
+func (s *ConcurrentSet[T]) Len() int {
+	shards := s.getShards()
+	n := 0
+	for i := range shards {
+		sh := &shards[i]
+		sh.mu.RLock()
+		n += len(sh.set)
+		sh.mu.RUnlock()
+	}
+	return n
+}
+
+// Values
+// returns the Set values
+func (s *ConcurrentSet[T]) Values() []T {
+	shards := s.getShards()
+	values := make([]T, 0, s.Len())
+	for i := range shards {
+		sh := &shards[i]
+		sh.mu.RLock()
+		for v := range sh.set {
+			values = append(values, v)
+		}
+		sh.mu.RUnlock()
+	}
+	return values
+}
+
+// Snapshot
+// returns the Set values as a plain (not concurrent) Set
+func (s *ConcurrentSet[T]) Snapshot() Set[T] {
+	shards := s.getShards()
+	result := make(Set[T], s.Len())
+	for i := range shards {
+		sh := &shards[i]
+		sh.mu.RLock()
+		for v := range sh.set {
+			result[v] = struct{}{}
+		}
+		sh.mu.RUnlock()
+	}
+	return result
+}
+
+// Merge
+// adds values of the other Sets
+func (s *ConcurrentSet[T]) Merge(others ...*ConcurrentSet[T]) {
+	for i := range others {
+		if others[i] == s {
+			continue
+		}
+		s.Add(others[i].Values()...)
+	}
+}
+
+// Diff
+// removes all values represented in any of the other Sets
+func (s *ConcurrentSet[T]) Diff(others ...*ConcurrentSet[T]) {
+	for i := range others {
+		if others[i] == s {
+			s.Truncate()
+			return
+		}
+	}
+
+	for i := range others {
+		s.Delete(others[i].Values()...)
+	}
+}
+
+// Intersect
+// removes all values not represented in all others Sets
+func (s *ConcurrentSet[T]) Intersect(others ...*ConcurrentSet[T]) {
+	if len(others) == 0 {
+		s.Truncate()
+		return
+	}
+
+	snapshots := make([]Set[T], 0, len(others))
+	for i := range others {
+		if others[i] != s {
+			snapshots = append(snapshots, others[i].Snapshot())
+		}
+	}
+
+	s.Filter(func(v T) bool {
+		for i := range snapshots {
+			if !snapshots[i].Has(v) {
+				return false
+			}
+		}
+		return true
+	})
+}
+
+// Equals
+// returns true if the Sets are equal to each other
+func (s *ConcurrentSet[T]) Equals(other *ConcurrentSet[T]) bool {
+	if s == other {
+		return true
+	}
+	return s.Snapshot().Equals(other.Snapshot())
+}
+
+// Filter
+// removes elements for which the func returns false;
+// the func is called with the shard lock held and must not call methods of the Set
+func (s *ConcurrentSet[T]) Filter(f func(T) bool) {
+	shards := s.getShards()
+	for i := range shards {
+		sh := &shards[i]
+		sh.mu.Lock()
+		for v := range sh.set {
+			if !f(v) {
+				delete(sh.set, v)
+			}
+		}
+		sh.mu.Unlock()
+	}
+}
+
+// Map
+// calls the func for each element;
+// all shards are locked for the duration of the call, so the func must not call methods of the Set
+func (s *ConcurrentSet[T]) Map(f func(T) T) {
+	shards := s.getShards()
+	for i := range shards {
+		shards[i].mu.Lock()
+	}
+
+	values := make([]T, 0)
+	for i := range shards {
+		for v := range shards[i].set {
+			values = append(values, f(v))
+		}
+		shards[i].set = Make[T]()
+	}
+	for _, v := range values {
+		shardOf(shards, v).set[v] = struct{}{}
+	}
+
+	for i := range shards {
+		shards[i].mu.Unlock()
+	}
+}
+
+// Copy
+// returns a copy of the Set with the same number of shards
+func (s *ConcurrentSet[T]) Copy() *ConcurrentSet[T] {
+	shards := s.getShards()
+	newSet := MakeConcurrentSharded[T](len(shards))
+	for i := range shards {
+		sh := &shards[i]
+		sh.mu.RLock()
+		newSet.shards[i].set = make(Set[T], len(sh.set))
+		for v := range sh.set {
+			newSet.shards[i].set[v] = struct{}{}
+		}
+		sh.mu.RUnlock()
+	}
+	return newSet
+}
@@ -0,0 +1,281 @@
+package sets
+
+import (
+	"reflect"
+	"sort"
+	"sync"
+	"testing"
+)
+
+func TestMakeConcurrent(t *testing.T) {
+	tests := []struct {
+		name   string
+		shards int
+		values []int
+		exp    Set[int]
+	}{
+		{name: "empty", shards: 4, exp: Set[int]{}},
+		{name: "zero_shards", shards: 0, values: []int{1, 2, 3}, exp: Make[int](1, 2, 3)},
+		{name: "values", shards: 4, values: []int{1, 2, 3, 4, 5}, exp: intSet},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := MakeConcurrentSharded[int](tt.shards, tt.values...).Snapshot(); !reflect.DeepEqual(got, tt.exp) {
+				t.Errorf(errorFormat, got, tt.exp)
+			}
+		})
+	}
+}
+
+func TestConcurrentSet_AddDeleteHas(t *testing.T) {
+	s := MakeConcurrent[string]("one", "two")
+	s.Add("three")
+	s.Delete("one", "none")
+
+	tests := []struct {
+		val string
+		exp bool
+	}{
+		{val: "one", exp: false},
+		{val: "two", exp: true},
+		{val: "three", exp: true},
+		{val: "none", exp: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.val, func(t *testing.T) {
+			if got := s.Has(tt.val); got != tt.exp {
+				t.Errorf(errorFormat, got, tt.exp)
+			}
+		})
+	}
+	if got := s.Len(); got != 2 {
+		t.Errorf(errorFormat, got, 2)
+	}
+}
+
+func TestConcurrentSet_Truncate(t *testing.T) {
+	s := MakeConcurrent[int](1, 2, 3)
+	s.Truncate()
+	if got := s.Len(); got != 0 {
+		t.Errorf(errorFormat, got, 0)
+	}
+}
+
+func TestConcurrentSet_Values(t *testing.T) {
+	got := MakeConcurrent[int](1, 2, 3).Values()
+	sort.Ints(got)
+	if exp := []int{1, 2, 3}; !reflect.DeepEqual(got, exp) {
+		t.Errorf(errorFormat, got, exp)
+	}
+}
+
+func TestConcurrentSet_Merge(t *testing.T) {
+	s := MakeConcurrent[int](1, 2)
+	s.Merge(MakeConcurrent[int](2, 3, 4), MakeConcurrent[int](3, 4, 5), s)
+	if got := s.Snapshot(); !reflect.DeepEqual(got, intSet) {
+		t.Errorf(errorFormat, got, intSet)
+	}
+}
+
+func TestConcurrentSet_Diff(t *testing.T) {
+	tests := []struct {
+		name   string
+		set    *ConcurrentSet[int]
+		others []*ConcurrentSet[int]
+		exp    Set[int]
+	}{
+		{name: "empty_others", set: MakeConcurrent[int](1, 2, 3), exp: Make[int](1, 2, 3)},
+		{name: "123", set: MakeConcurrent[int](1, 2, 3, 4, 5), others: []*ConcurrentSet[int]{MakeConcurrent[int](4), MakeConcurrent[int](5)}, exp: Make[int](1, 2, 3)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.set.Diff(tt.others...)
+			if got := tt.set.Snapshot(); !reflect.DeepEqual(got, tt.exp) {
+				t.Errorf(errorFormat, got, tt.exp)
+			}
+		})
+	}
+
+	t.Run("self", func(t *testing.T) {
+		s := MakeConcurrent[int](1, 2, 3)
+		s.Diff(s)
+		if got := s.Len(); got != 0 {
+			t.Errorf(errorFormat, got, 0)
+		}
+	})
+}
+
+func TestConcurrentSet_Intersect(t *testing.T) {
+	tests := []struct {
+		name   string
+		set    *ConcurrentSet[int]
+		others []*ConcurrentSet[int]
+		exp    Set[int]
+	}{
+		{name: "empty_others", set: MakeConcurrent[int](1, 2, 3), exp: Make[int]()},
+		{name: "123", set: MakeConcurrent[int](1, 2, 3, 4, 5), others: []*ConcurrentSet[int]{MakeConcurrent[int](1, 2, 3, 4), MakeConcurrent[int](1, 2, 3, 5)}, exp: Make[int](1, 2, 3)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.set.Intersect(tt.others...)
+			if got := tt.set.Snapshot(); !reflect.DeepEqual(got, tt.exp) {
+				t.Errorf(errorFormat, got, tt.exp)
+			}
+		})
+	}
+
+	t.Run("self", func(t *testing.T) {
+		s := MakeConcurrent[int](1, 2, 3)
+		s.Intersect(s, MakeConcurrent[int](2, 3))
+		if got, exp := s.Snapshot(), Make[int](2, 3); !reflect.DeepEqual(got, exp) {
+			t.Errorf(errorFormat, got, exp)
+		}
+	})
+}
+
+func TestConcurrentSet_Equals(t *testing.T) {
+	tests := []struct {
+		name  string
+		set   *ConcurrentSet[string]
+		other *ConcurrentSet[string]
+		exp   bool
+	}{
+		{name: "empty", set: MakeConcurrent[string](), other: MakeConcurrent[string](), exp: true},
+		{name: "different_shards", set: MakeConcurrentSharded[string](2, "1", "2"), other: MakeConcurrentSharded[string](7, "1", "2"), exp: true},
+		{name: "not_equals", set: MakeConcurrent[string]("1", "2"), other: MakeConcurrent[string]("1", "3"), exp: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.set.Equals(tt.other); got != tt.exp {
+				t.Errorf(errorFormat, got, tt.exp)
+			}
+		})
+	}
+}
+
+func TestConcurrentSet_Filter(t *testing.T) {
+	s := MakeConcurrent[int](1, 2, 3, 4, 5)
+	s.Filter(func(i int) bool { return i < 4 })
+	if got, exp := s.Snapshot(), Make[int](1, 2, 3); !reflect.DeepEqual(got, exp) {
+		t.Errorf(errorFormat, got, exp)
+	}
+}
+
+func TestConcurrentSet_Map(t *testing.T) {
+	s := MakeConcurrent[int](1, 2, 3)
+	s.Map(func(i int) int { return i + 1 })
+	if got, exp := s.Snapshot(), Make[int](2, 3, 4); !reflect.DeepEqual(got, exp) {
+		t.Errorf(errorFormat, got, exp)
+	}
+	for _, v := range []int{2, 3, 4} {
+		if !s.Has(v) {
+			t.Errorf(errorFormat, s.Has(v), true)
+		}
+	}
+}
+
+func TestConcurrentSet_Copy(t *testing.T) {
+	s := MakeConcurrent[string]("one", "two")
+	c := s.Copy()
+	s.Add("three")
+	if got, exp := c.Snapshot(), Make[string]("one", "two"); !reflect.DeepEqual(got, exp) {
+		t.Errorf(errorFormat, got, exp)
+	}
+	if !c.Has("two") {
+		t.Errorf(errorFormat, c.Has("two"), true)
+	}
+}
+
+func TestConcurrentSet_ZeroValue(t *testing.T) {
+	var s ConcurrentSet[int]
+	if s.Len() != 0 || s.Has(1) {
+		t.Errorf(errorFormat, s.Values(), []int{})
+	}
+
+	s.Add(1, 2, 3)
+	s.Delete(2)
+	if exp := Make[int](1, 3); !reflect.DeepEqual(s.Snapshot(), exp) {
+		t.Errorf(errorFormat, s.Snapshot(), exp)
+	}
+
+	var other ConcurrentSet[int]
+	other.Merge(&s)
+	if !other.Equals(&s) || other.Copy().Len() != 2 {
+		t.Errorf(errorFormat, other.Values(), s.Values())
+	}
+
+	var shared ConcurrentSet[int]
+	var wg sync.WaitGroup
+	for w := 0; w < 4; w++ {
+		wg.Add(1)
+		go func(w int) {
+			defer wg.Done()
+			shared.Len()
+			shared.Add(w)
+		}(w)
+	}
+	wg.Wait()
+	if got := shared.Len(); got != 4 {
+		t.Errorf(errorFormat, got, 4)
+	}
+}
+
+// TestConcurrentSet_Parallel is meant to be run with the race detector (go test -race)
+func TestConcurrentSet_Parallel(t *testing.T) {
+	const (
+		workers = 8
+		perW    = 1000
+	)
+
+	s := MakeConcurrentSharded[int](4)
+	other := MakeConcurrent[int]()
+
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func(w int) {
+			defer wg.Done()
+			for i := 0; i < perW; i++ {
+				v := w*perW + i
+				s.Add(v)
+				other.Add(v)
+				s.Has(v)
+				s.Len()
+				if i%100 == 0 {
+					s.Values()
+					s.Merge(other)
+					s.Equals(other)
+					s.Copy()
+				}
+			}
+		}(w)
+	}
+	wg.Wait()
+
+	if got := s.Len(); got != workers*perW {
+		t.Errorf(errorFormat, got, workers*perW)
+	}
+
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func(w int) {
+			defer wg.Done()
+			switch w % 4 {
+			case 0:
+				s.Filter(func(i int) bool { return i%2 == 0 })
+			case 1:
+				s.Map(func(i int) int { return i })
+			case 2:
+				s.Intersect(other)
+			default:
+				s.Delete(w)
+			}
+		}(w)
+	}
+	wg.Wait()
+
+	s.Filter(func(i int) bool { return i%2 == 0 })
+	if got := s.Len(); got > workers*perW/2 {
+		t.Errorf(errorFormat, got, workers*perW/2)
+	}
+}
@@ -0,0 +1,293 @@
+package sets
+
+import (
+	"math/bits"
+
+	"github.com/goiste/generics/internal/hashing"
+)
+
+const (
+	hamtBits = 5
+	hamtMask = 1<<hamtBits - 1
+)
+
+// Frozen represents an immutable set of elements of type T.
+// It is a persistent hash array mapped trie: With, Without and Union return new versions
+// that share unchanged structure with the original, so updates are O(log n) and copying
+// a Frozen value is a cheap snapshot. The zero value is an empty set.
+type Frozen[T comparable] struct {
+	root *hamtNode[T]
+	size int
+}
+
+// hamtNode is a branch if values is empty and a leaf holding values with equal hashes otherwise
+type hamtNode[T comparable] struct {
+	bitmap  uint32
+	entries []*hamtNode[T]
+	hash    uint64
+	values  []T
+}
+
+// MakeFrozen
+// creates a new Frozen set of type T
+func MakeFrozen[T comparable](values ...T) Frozen[T] {
+	return Frozen[T]{}.With(values...)
+}
+
+// Freeze
+// creates a new Frozen set containing the values of the Set
+func Freeze[T comparable](s Set[T]) Frozen[T] {
+	f := Frozen[T]{}
+	for v := range s {
+		f = f.With(v)
+	}
+	return f
+}
+
+// With
+// returns a set containing the values of the Frozen set and the given values
+func (f Frozen[T]) With(values ...T) Frozen[T] {
+	for _, v := range values {
+		root, added := hamtInsert(f.root, hashing.Sum64(v), 0, v)
+		if added {
+			f = Frozen[T]{root: root, size: f.size + 1}
+		}
+	}
+	return f
+}
+
+// Without
+// returns a set containing the values of the Frozen set except the given values
+func (f Frozen[T]) Without(values ...T) Frozen[T] {
+	for _, v := range values {
+		root, removed := hamtRemove(f.root, hashing.Sum64(v), 0, v)
+		if removed {
+			f = Frozen[T]{root: root, size: f.size - 1}
+		}
+	}
+	return f
+}
+
+// Union
+// returns a set containing the values of the Frozen set and all the other sets;
+// the smaller side is inserted into the larger one
+func (f Frozen[T]) Union(others ...Frozen[T]) Frozen[T] {
+	for i := range others {
+		base, add := f, others[i]
+		if add.size > base.size {
+			base, add = add, base
+		}
+		add.Each(func(v T) bool {
+			base = base.With(v)
+			return true
+		})
+		f = base
+	}
+	return f
+}
+
+// Has
+// returns true if the set contains the value or false if not
+func (f Frozen[T]) Has(value T) bool {
+	hash := hashing.Sum64(value)
+	n := f.root
+	for shift := uint(0); n != nil; shift += hamtBits {
+		if len(n.values) > 0 {
+			if n.hash != hash {
+				return false
+			}
+			for _, v := range n.values {
+				if v == value {
+					return true
+				}
+			}
+			return false
+		}
+		bit := uint32(1) << ((hash >> shift) & hamtMask)
+		if n.bitmap&bit == 0 {
+			return false
+		}
+		n = n.entries[bits.OnesCount32(n.bitmap&(bit-1))]
+	}
+	return false
+}
+
+// Len
+// returns the length of the set
+func (f Frozen[T]) Len() int {
+	return f.size
+}
+
+// Values
+// returns the set values
+func (f Frozen[T]) Values() []T {
+	values := make([]T, 0, f.size)
+	f.Each(func(v T) bool {
+		values = append(values, v)
+		return true
+	})
+	return values
+}
+
+// Each
+// calls the func for each element until the func returns false
+func (f Frozen[T]) Each(fn func(T) bool) {
+	hamtEach(f.root, fn)
+}
+
+// Equals
+// returns true if the sets are equal to each other
+func (f Frozen[T]) Equals(other Frozen[T]) bool {
+	if f.size != other.size {
+		return false
+	}
+	if f.root == other.root {
+		return true
+	}
+
+	equal := true
+	f.Each(func(v T) bool {
+		equal = other.Has(v)
+		return equal
+	})
+	return equal
+}
+
+// ToSet
+// returns the values as a new mutable Set
+func (f Frozen[T]) ToSet() Set[T] {
+	s := make(Set[T], f.size)
+	f.Each(func(v T) bool {
+		s[v] = struct{}{}
+		return true
+	})
+	return s
+}
+
+func hamtEach[T comparable](n *hamtNode[T], fn func(T) bool) bool {
+	if n == nil {
+		return true
+	}
+	for _, v := range n.values {
+		if !fn(v) {
+			return false
+		}
+	}
+	for _, e := range n.entries {
+		if !hamtEach(e, fn) {
+			return false
+		}
+	}
+	return true
+}
+
+func hamtInsert[T comparable](n *hamtNode[T], hash uint64, shift uint, value T) (*hamtNode[T], bool) {
+	if n == nil {
+		return &hamtNode[T]{hash: hash, values: []T{value}}, true
+	}
+
+	if len(n.values) > 0 {
+		if n.hash == hash {
+			for _, v := range n.values {
+				if v == value {
+					return n, false
+				}
+			}
+			values := make([]T, len(n.values), len(n.values)+1)
+			copy(values, n.values)
+			return &hamtNode[T]{hash: hash, values: append(values, value)}, true
+		}
+		return hamtJoin(n, &hamtNode[T]{hash: hash, values: []T{value}}, shift), true
+	}
+
+	bit := uint32(1) << ((hash >> shift) & hamtMask)
+	pos := bits.OnesCount32(n.bitmap & (bit - 1))
+
+	if n.bitmap&bit == 0 {
+		entries := make([]*hamtNode[T], len(n.entries)+1)
+		copy(entries, n.entries[:pos])
+		entries[pos] = &hamtNode[T]{hash: hash, values: []T{value}}
+		copy(entries[pos+1:], n.entries[pos:])
+		return &hamtNode[T]{bitmap: n.bitmap | bit, entries: entries}, true
+	}
+
+	child, added := hamtInsert(n.entries[pos], hash, shift+hamtBits, value)
+	if !added {
+		return n, false
+	}
+	entries := make([]*hamtNode[T], len(n.entries))
+	copy(entries, n.entries)
+	entries[pos] = child
+	return &hamtNode[T]{bitmap: n.bitmap, entries: entries}, true
+}
+
+// hamtJoin returns a branch at the given shift containing two leaves with different hashes
+func hamtJoin[T comparable](a, b *hamtNode[T], shift uint) *hamtNode[T] {
+	ia, ib := (a.hash>>shift)&hamtMask, (b.hash>>shift)&hamtMask
+	if ia == ib {
+		return &hamtNode[T]{bitmap: 1 << ia, entries: []*hamtNode[T]{hamtJoin(a, b, shift+hamtBits)}}
+	}
+	if ia > ib {
+		a, b = b, a
+		ia, ib = ib, ia
+	}
+	return &hamtNode[T]{bitmap: 1<<ia | 1<<ib, entries: []*hamtNode[T]{a, b}}
+}
+
+func hamtRemove[T comparable](n *hamtNode[T], hash uint64, shift uint, value T) (*hamtNode[T], bool) {
+	if n == nil {
+		return nil, false
+	}
+
+	if len(n.values) > 0 {
+		if n.hash != hash {
+			return n, false
+		}
+		for i, v := range n.values {
+			if v != value {
+				continue
+			}
+			if len(n.values) == 1 {
+				return nil, true
+			}
+			values := make([]T, 0, len(n.values)-1)
+			values = append(values, n.values[:i]...)
+			values = append(values, n.values[i+1:]...)
+			return &hamtNode[T]{hash: hash, values: values}, true
+		}
+		return n, false
+	}
+
+	bit := uint32(1) << ((hash >> shift) & hamtMask)
+	if n.bitmap&bit == 0 {
+		return n, false
+	}
+	pos := bits.OnesCount32(n.bitmap & (bit - 1))
+
+	child, removed := hamtRemove(n.entries[pos], hash, shift+hamtBits, value)
+	if !removed {
+		return n, false
+	}
+
+	if child == nil {
+		if len(n.entries) == 1 {
+			return nil, true
+		}
+		if len(n.entries) == 2 && len(n.entries[1-pos].values) > 0 {
+			// a single remaining leaf can move up to the parent
+			return n.entries[1-pos], true
+		}
+		entries := make([]*hamtNode[T], 0, len(n.entries)-1)
+		entries = append(entries, n.entries[:pos]...)
+		entries = append(entries, n.entries[pos+1:]...)
+		return &hamtNode[T]{bitmap: n.bitmap &^ bit, entries: entries}, true
+	}
+
+	if len(n.entries) == 1 && len(child.values) > 0 {
+		return child, true
+	}
+	entries := make([]*hamtNode[T], len(n.entries))
+	copy(entries, n.entries)
+	entries[pos] = child
+	return &hamtNode[T]{bitmap: n.bitmap, entries: entries}, true
+}
@@ -0,0 +1,180 @@
+package sets
+
+import (
+	"math/rand"
+	"reflect"
+	"sort"
+	"testing"
+)
+
+func TestMakeFrozen(t *testing.T) {
+	tests := []struct {
+		name   string
+		values []int
+		exp    Set[int]
+	}{
+		{name: "empty", exp: Set[int]{}},
+		{name: "values", values: []int{1, 2, 3, 4, 5, 5, 1}, exp: intSet},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := MakeFrozen[int](tt.values...)
+			if got := f.ToSet(); !reflect.DeepEqual(got, tt.exp) {
+				t.Errorf(errorFormat, got, tt.exp)
+			}
+			if got := f.Len(); got != len(tt.exp) {
+				t.Errorf(errorFormat, got, len(tt.exp))
+			}
+		})
+	}
+}
+
+func TestFreeze(t *testing.T) {
+	s := Make[string]("one", "two")
+	f := Freeze(s)
+	s.Add("three")
+	if got, exp := f.ToSet(), Make[string]("one", "two"); !reflect.DeepEqual(got, exp) {
+		t.Errorf(errorFormat, got, exp)
+	}
+}
+
+func TestFrozen_ZeroValue(t *testing.T) {
+	var f Frozen[string]
+	got := []any{f.Len(), f.Has("a"), f.Values(), f.Without("a").Len()}
+	exp := []any{0, false, []string{}, 0}
+	if !reflect.DeepEqual(got, exp) {
+		t.Errorf(errorFormat, got, exp)
+	}
+}
+
+func TestFrozen_Persistence(t *testing.T) {
+	v1 := MakeFrozen[int](1, 2, 3)
+	v2 := v1.With(4)
+	v3 := v2.Without(1)
+	v4 := v3.With(4)
+
+	tests := []struct {
+		name string
+		set  Frozen[int]
+		exp  Set[int]
+	}{
+		{name: "v1", set: v1, exp: Make[int](1, 2, 3)},
+		{name: "v2", set: v2, exp: Make[int](1, 2, 3, 4)},
+		{name: "v3", set: v3, exp: Make[int](2, 3, 4)},
+		{name: "v4", set: v4, exp: Make[int](2, 3, 4)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.set.ToSet(); !reflect.DeepEqual(got, tt.exp) {
+				t.Errorf(errorFormat, got, tt.exp)
+			}
+		})
+	}
+	if v3.root != v4.root {
+		t.Errorf(errorFormat, "new root", "shared root")
+	}
+}
+
+func TestFrozen_Random(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	f := Frozen[int]{}
+	ref := Make[int]()
+	for i := 0; i < 20000; i++ {
+		v := r.Intn(3000)
+		if r.Intn(3) == 0 {
+			f = f.Without(v)
+			ref.Delete(v)
+		} else {
+			f = f.With(v)
+			ref.Add(v)
+		}
+	}
+	if got := f.ToSet(); !reflect.DeepEqual(got, ref) {
+		t.Fatalf(errorFormat, got.Len(), ref.Len())
+	}
+	if got := f.Len(); got != ref.Len() {
+		t.Errorf(errorFormat, got, ref.Len())
+	}
+	for v := 0; v < 3000; v++ {
+		if f.Has(v) != ref.Has(v) {
+			t.Fatalf(errorFormat, f.Has(v), ref.Has(v))
+		}
+	}
+}
+
+func TestFrozen_Collisions(t *testing.T) {
+	// hashes sharing the first levels and fully colliding values exercise joins and collision leaves
+	hashes := map[string]uint64{"a": 0x1f, "b": 0x3f, "c": 0x3f, "d": 0x5f}
+
+	var root *hamtNode[string]
+	for _, v := range []string{"a", "b", "c", "d", "c"} {
+		root, _ = hamtInsert(root, hashes[v], 0, v)
+	}
+	got := make([]string, 0)
+	hamtEach(root, func(v string) bool {
+		got = append(got, v)
+		return true
+	})
+	sort.Strings(got)
+	if exp := []string{"a", "b", "c", "d"}; !reflect.DeepEqual(got, exp) {
+		t.Errorf(errorFormat, got, exp)
+	}
+
+	for _, v := range []string{"b", "a", "d"} {
+		var removed bool
+		if root, removed = hamtRemove(root, hashes[v], 0, v); !removed {
+			t.Errorf(errorFormat, removed, true)
+		}
+	}
+	if root == nil || !reflect.DeepEqual(root.values, []string{"c"}) {
+		t.Errorf(errorFormat, root, "leaf c")
+	}
+	if root, _ = hamtRemove(root, hashes["c"], 0, "c"); root != nil {
+		t.Errorf(errorFormat, root, nil)
+	}
+}
+
+func TestFrozen_Union(t *testing.T) {
+	a := MakeFrozen[int](1, 2)
+	got := a.Union(MakeFrozen[int](2, 3, 4), MakeFrozen[int](5), Frozen[int]{})
+	if !reflect.DeepEqual(got.ToSet(), intSet) {
+		t.Errorf(errorFormat, got.ToSet(), intSet)
+	}
+	if exp := Make[int](1, 2); !reflect.DeepEqual(a.ToSet(), exp) {
+		t.Errorf(errorFormat, a.ToSet(), exp)
+	}
+}
+
+func TestFrozen_Each(t *testing.T) {
+	n := 0
+	MakeFrozen[int](1, 2, 3, 4, 5).Each(func(int) bool {
+		n++
+		return n < 3
+	})
+	if n != 3 {
+		t.Errorf(errorFormat, n, 3)
+	}
+}
+
+func TestFrozen_Equals(t *testing.T) {
+	base := MakeFrozen[string]("1", "2")
+	tests := []struct {
+		name  string
+		set   Frozen[string]
+		other Frozen[string]
+		exp   bool
+	}{
+		{name: "empty", exp: true},
+		{name: "same", set: base, other: base, exp: true},
+		{name: "equals", set: base, other: MakeFrozen[string]("2", "1"), exp: true},
+		{name: "not_equals", set: base, other: MakeFrozen[string]("1", "3"), exp: false},
+		{name: "not_equals_len", set: base, other: base.With("3"), exp: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.set.Equals(tt.other); got != tt.exp {
+				t.Errorf(errorFormat, got, tt.exp)
+			}
+		})
+	}
+}
@@ -0,0 +1,41 @@
+//go:build go1.23
+
+package sets
+
+import "iter"
+
+// All
+// returns an iterator over the Set elements
+func (s Set[T]) All() iter.Seq[T] {
+	return s.Each
+}
+
+// All
+// returns an iterator over the Set elements in insertion order
+func (s *OrderedSet[T]) All() iter.Seq[T] {
+	return s.Each
+}
+
+// All
+// returns an iterator over the Set elements in ascending order
+func (s *SortedSet[T]) All() iter.Seq[T] {
+	return s.Each
+}
+
+// All
+// returns an iterator over the set elements
+func (f Frozen[T]) All() iter.Seq[T] {
+	return f.Each
+}
+
+// All
+// returns an iterator over the BitSet values in ascending order
+func (b *BitSet) All() iter.Seq[int] {
+	return b.Each
+}
+
+// All
+// returns an iterator over the set values in ascending order
+func (r *Roaring) All() iter.Seq[uint32] {
+	return r.Each
+}
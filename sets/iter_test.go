@@ -0,0 +1,65 @@
+//go:build go1.23
+
+package sets
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+)
+
+func TestSet_All(t *testing.T) {
+	got := make([]int, 0)
+	for v := range intSet.All() {
+		got = append(got, v)
+	}
+	sort.Ints(got)
+	if exp := []int{1, 2, 3, 4, 5}; !reflect.DeepEqual(got, exp) {
+		t.Errorf(errorFormat, got, exp)
+	}
+
+	n := 0
+	for range intSet.All() {
+		n++
+		if n == 2 {
+			break
+		}
+	}
+	if n != 2 {
+		t.Errorf(errorFormat, n, 2)
+	}
+}
+
+func TestOrderedSet_All(t *testing.T) {
+	got := make([]int, 0)
+	for v := range MakeOrdered[int](3, 1, 2).All() {
+		got = append(got, v)
+	}
+	if exp := []int{3, 1, 2}; !reflect.DeepEqual(got, exp) {
+		t.Errorf(errorFormat, got, exp)
+	}
+}
+
+func TestSortedSet_All(t *testing.T) {
+	got := make([]int, 0)
+	for v := range MakeSorted[int](3, 1, 2).All() {
+		if v == 3 {
+			break
+		}
+		got = append(got, v)
+	}
+	if exp := []int{1, 2}; !reflect.DeepEqual(got, exp) {
+		t.Errorf(errorFormat, got, exp)
+	}
+}
+
+func TestFrozen_All(t *testing.T) {
+	got := make([]int, 0)
+	for v := range MakeFrozen[int](3, 1, 2).All() {
+		got = append(got, v)
+	}
+	sort.Ints(got)
+	if exp := []int{1, 2, 3}; !reflect.DeepEqual(got, exp) {
+		t.Errorf(errorFormat, got, exp)
+	}
+}
@@ -0,0 +1,153 @@
+package sets
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+)
+
+// ErrDuplicate is returned when decoded data contains the same element more than once
+var ErrDuplicate = errors.New("sets: duplicate element")
+
+// MarshalJSON
+// encodes the Set as a JSON array; elements are sorted when T is an ordered type
+// (numbers, strings) and sorted by their JSON encoding otherwise, so output is deterministic
+func (s Set[T]) MarshalJSON() ([]byte, error) {
+	if s == nil {
+		return []byte("null"), nil
+	}
+
+	encoded, err := sortedJSON(s.Values())
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	buf.WriteByte('[')
+	for i, b := range encoded {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		buf.Write(b)
+	}
+	buf.WriteByte(']')
+
+	return buf.Bytes(), nil
+}
+
+// UnmarshalJSON
+// decodes the Set from a JSON array, replacing its contents;
+// returns an error wrapping ErrDuplicate if the array contains duplicates
+func (s *Set[T]) UnmarshalJSON(data []byte) error {
+	return s.unmarshalJSON(data, false)
+}
+
+// UnmarshalJSONTolerant
+// decodes the Set from a JSON array like UnmarshalJSON, but collapses duplicates silently
+func (s *Set[T]) UnmarshalJSONTolerant(data []byte) error {
+	return s.unmarshalJSON(data, true)
+}
+
+// TolerantSet represents a Set whose JSON decoding collapses duplicates instead of rejecting them;
+// use it as a struct field type for payloads that may repeat elements and convert it with Set[T](ts)
+type TolerantSet[T comparable] Set[T]
+
+// MarshalJSON
+// encodes the Set like Set.MarshalJSON
+func (ts TolerantSet[T]) MarshalJSON() ([]byte, error) {
+	return Set[T](ts).MarshalJSON()
+}
+
+// UnmarshalJSON
+// decodes the Set like Set.UnmarshalJSONTolerant
+func (ts *TolerantSet[T]) UnmarshalJSON(data []byte) error {
+	return (*Set[T])(ts).UnmarshalJSONTolerant(data)
+}
+
+func (s *Set[T]) unmarshalJSON(data []byte, tolerateDuplicates bool) error {
+	var values []T
+	if err := json.Unmarshal(data, &values); err != nil {
+		return err
+	}
+
+	if values == nil {
+		*s = nil
+		return nil
+	}
+
+	result := make(Set[T], len(values))
+	for _, v := range values {
+		if _, e := result[v]; e && !tolerateDuplicates {
+			return fmt.Errorf("%w: %v", ErrDuplicate, v)
+		}
+		result[v] = struct{}{}
+	}
+	*s = result
+
+	return nil
+}
+
+// sortValues sorts values of ordered kinds by value and the rest by their JSON encoding
+func sortValues[T any](values []T) error {
+	if less := orderedLess(values); less != nil {
+		sort.Slice(values, less)
+		return nil
+	}
+	_, err := sortedJSON(values)
+	return err
+}
+
+// sortedJSON encodes each value once, sorts the values like sortValues
+// and returns their encodings in the sorted order
+func sortedJSON[T any](values []T) ([][]byte, error) {
+	encoded := make([][]byte, len(values))
+	for i := range values {
+		b, err := json.Marshal(values[i])
+		if err != nil {
+			return nil, err
+		}
+		encoded[i] = b
+	}
+
+	less := orderedLess(values)
+	if less == nil {
+		less = func(i, j int) bool { return bytes.Compare(encoded[i], encoded[j]) < 0 }
+	}
+	sort.Sort(byKey[T]{values: values, encoded: encoded, less: less})
+
+	return encoded, nil
+}
+
+// orderedLess returns a less func comparing values of ordered kinds by value, or nil for other kinds
+func orderedLess[T any](values []T) func(i, j int) bool {
+	rv := reflect.ValueOf(values)
+	switch rv.Type().Elem().Kind() {
+	case reflect.String:
+		return func(i, j int) bool { return rv.Index(i).String() < rv.Index(j).String() }
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return func(i, j int) bool { return rv.Index(i).Int() < rv.Index(j).Int() }
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return func(i, j int) bool { return rv.Index(i).Uint() < rv.Index(j).Uint() }
+	case reflect.Float32, reflect.Float64:
+		return func(i, j int) bool { return rv.Index(i).Float() < rv.Index(j).Float() }
+	default:
+		return nil
+	}
+}
+
+// byKey sorts values together with their JSON encodings
+type byKey[T any] struct {
+	values  []T
+	encoded [][]byte
+	less    func(i, j int) bool
+}
+
+func (b byKey[T]) Len() int           { return len(b.values) }
+func (b byKey[T]) Less(i, j int) bool { return b.less(i, j) }
+func (b byKey[T]) Swap(i, j int) {
+	b.values[i], b.values[j] = b.values[j], b.values[i]
+	b.encoded[i], b.encoded[j] = b.encoded[j], b.encoded[i]
+}
@@ -0,0 +1,184 @@
+package sets
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+type jsonPoint struct {
+	X int    `json:"x"`
+	Y int    `json:"y"`
+	L string `json:"l,omitempty"`
+}
+
+func TestSet_MarshalJSON(t *testing.T) {
+	tests := []struct {
+		name string
+		set  any
+		exp  string
+	}{
+		{name: "nil", set: Set[int](nil), exp: `null`},
+		{name: "empty", set: Make[int](), exp: `[]`},
+		{name: "int", set: Make[int](3, -1, 2), exp: `[-1,2,3]`},
+		{name: "uint", set: Make[uint8](3, 10, 2), exp: `[2,3,10]`},
+		{name: "float", set: Make[float64](0.5, -1.5, 0.25), exp: `[-1.5,0.25,0.5]`},
+		{name: "string", set: stringSet, exp: `["five","four","one","three","two"]`},
+		{name: "struct", set: Make[jsonPoint](jsonPoint{2, 1, ""}, jsonPoint{1, 2, "a"}), exp: `[{"x":1,"y":2,"l":"a"},{"x":2,"y":1}]`},
+		{name: "field", set: struct {
+			Tags Set[string] `json:"tags"`
+		}{Tags: Make[string]("b", "a")}, exp: `{"tags":["a","b"]}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := json.Marshal(tt.set)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(got) != tt.exp {
+				t.Errorf(errorFormat, string(got), tt.exp)
+			}
+		})
+	}
+}
+
+var errBadJSON = errors.New("bad element")
+
+// countedJSON counts its encodings and fails to encode negative values
+type countedJSON int
+
+var countedJSONCalls int
+
+func (c countedJSON) MarshalJSON() ([]byte, error) {
+	countedJSONCalls++
+	if c < 0 {
+		return nil, errBadJSON
+	}
+	return []byte(`"` + string(rune('a'+c)) + `"`), nil
+}
+
+func TestSet_MarshalJSON_EncodesOnce(t *testing.T) {
+	countedJSONCalls = 0
+	got, err := Make[countedJSON](2, 0, 1).MarshalJSON()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if exp := `["a","b","c"]`; string(got) != exp {
+		t.Errorf(errorFormat, string(got), exp)
+	}
+	if countedJSONCalls != 3 {
+		t.Errorf(errorFormat, countedJSONCalls, 3)
+	}
+
+	if _, err = Make[countedJSON](1, -1).MarshalJSON(); !errors.Is(err, errBadJSON) {
+		t.Errorf(errorFormat, err, errBadJSON)
+	}
+}
+
+func TestSet_UnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		name string
+		data string
+		exp  Set[int]
+		err  error
+	}{
+		{name: "null", data: `null`, exp: nil},
+		{name: "empty", data: `[]`, exp: Set[int]{}},
+		{name: "values", data: `[1,2,3,4,5]`, exp: intSet},
+		{name: "duplicates", data: `[1,2,1]`, exp: Make[int](42), err: ErrDuplicate},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Make[int](42)
+			err := json.Unmarshal([]byte(tt.data), &got)
+			if !errors.Is(err, tt.err) {
+				t.Errorf(errorFormat, err, tt.err)
+			}
+			if !reflect.DeepEqual(got, tt.exp) {
+				t.Errorf(errorFormat, got, tt.exp)
+			}
+		})
+	}
+
+	t.Run("invalid", func(t *testing.T) {
+		var got Set[int]
+		if err := json.Unmarshal([]byte(`{"1":{}}`), &got); err == nil {
+			t.Errorf(errorFormat, err, "error")
+		}
+	})
+}
+
+func TestSet_UnmarshalJSONTolerant(t *testing.T) {
+	var got Set[string]
+	if err := got.UnmarshalJSONTolerant([]byte(`["a","b","a"]`)); err != nil {
+		t.Fatal(err)
+	}
+	if exp := Make[string]("a", "b"); !reflect.DeepEqual(got, exp) {
+		t.Errorf(errorFormat, got, exp)
+	}
+}
+
+func TestTolerantSet_JSON(t *testing.T) {
+	type payload struct {
+		Tags   TolerantSet[string] `json:"tags"`
+		Strict Set[string]         `json:"strict"`
+	}
+	tests := []struct {
+		name string
+		data string
+		exp  payload
+		err  error
+	}{
+		{name: "duplicates", data: `{"tags":["b","a","b"],"strict":["x"]}`, exp: payload{Tags: TolerantSet[string](Make[string]("a", "b")), Strict: Make[string]("x")}},
+		{name: "null", data: `{"tags":null}`, exp: payload{}},
+		{name: "strict field", data: `{"tags":["a"],"strict":["x","x"]}`, err: ErrDuplicate},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got payload
+			err := json.Unmarshal([]byte(tt.data), &got)
+			if !errors.Is(err, tt.err) {
+				t.Fatalf(errorFormat, err, tt.err)
+			}
+			if tt.err == nil && !reflect.DeepEqual(got, tt.exp) {
+				t.Errorf(errorFormat, got, tt.exp)
+			}
+		})
+	}
+
+	data, err := json.Marshal(payload{Tags: TolerantSet[string](Make[string]("b", "a"))})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if exp := `{"tags":["a","b"],"strict":null}`; string(data) != exp {
+		t.Errorf(errorFormat, string(data), exp)
+	}
+}
+
+func TestSet_JSONRoundTrip(t *testing.T) {
+	t.Run("string", func(t *testing.T) {
+		testJSONRoundTrip(t, stringSet)
+	})
+	t.Run("int", func(t *testing.T) {
+		testJSONRoundTrip(t, intSet)
+	})
+	t.Run("struct", func(t *testing.T) {
+		testJSONRoundTrip(t, Make[jsonPoint](jsonPoint{1, 2, "a"}, jsonPoint{2, 1, ""}, jsonPoint{}))
+	})
+}
+
+func testJSONRoundTrip[T comparable](t *testing.T, s Set[T]) {
+	t.Helper()
+	data, err := json.Marshal(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got Set[T]
+	if err = json.Unmarshal(data, &got); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(got, s) {
+		t.Errorf(errorFormat, got, s)
+	}
+}
@@ -0,0 +1,244 @@
+package sets
+
+import "sort"
+
+// MultiSet represents a multiset (bag) of elements of type T with their counts
+type MultiSet[T comparable] map[T]int
+
+// Counted represents an element of a MultiSet with its count
+type Counted[T any] struct {
+	Value T
+	Count int
+}
+
+// MakeMulti
+// creates a new MultiSet of type T; each value is counted once per occurrence
+func MakeMulti[T comparable](values ...T) MultiSet[T] {
+	m := make(MultiSet[T])
+	m.Add(values...)
+	return m
+}
+
+// MultiFromSet
+// creates a new MultiSet containing each element of the Set once
+func MultiFromSet[T comparable](s Set[T]) MultiSet[T] {
+	m := make(MultiSet[T], len(s))
+	for v := range s {
+		m[v] = 1
+	}
+	return m
+}
+
+// Add
+// adds one occurrence of each value
+func (m MultiSet[T]) Add(values ...T) {
+	for _, v := range values {
+		m[v]++
+	}
+}
+
+// AddN
+// adds `n` occurrences of the value; non-positive `n` is ignored
+func (m MultiSet[T]) AddN(value T, n int) {
+	if n <= 0 {
+		return
+	}
+	m[value] += n
+}
+
+// Remove
+// removes one occurrence of each value
+func (m MultiSet[T]) Remove(values ...T) {
+	for _, v := range values {
+		m.RemoveN(v, 1)
+	}
+}
+
+// RemoveN
+// removes up to `n` occurrences of the value; non-positive `n` is ignored
+func (m MultiSet[T]) RemoveN(value T, n int) {
+	if n <= 0 {
+		return
+	}
+	if m[value] <= n {
+		delete(m, value)
+		return
+	}
+	m[value] -= n
+}
+
+// Delete
+// removes all occurrences of the values
+func (m MultiSet[T]) Delete(values ...T) {
+	for _, v := range values {
+		delete(m, v)
+	}
+}
+
+// Truncate
+// deletes all values from the MultiSet
+func (m *MultiSet[T]) Truncate() {
+	*m = MakeMulti[T]()
+}
+
+// Has
+// returns true if the MultiSet contains at least one occurrence of the value
+func (m MultiSet[T]) Has(value T) bool {
+	return m[value] > 0
+}
+
+// Count
+// returns the number of occurrences of the value
+func (m MultiSet[T]) Count(value T) int {
+	return m[value]
+}
+
+// Len
+// returns the number of distinct elements
+func (m MultiSet[T]) Len() int {
+	return len(m)
+}
+
+// Total
+// returns the number of elements including duplicates
+func (m MultiSet[T]) Total() int {
+	total := 0
+	for _, c := range m {
+		total += c
+	}
+	return total
+}
+
+// Values
+// returns the elements, each repeated as many times as it occurs
+func (m MultiSet[T]) Values() []T {
+	values := make([]T, 0, m.Total())
+	for v, c := range m {
+		for i := 0; i < c; i++ {
+			values = append(values, v)
+		}
+	}
+	return values
+}
+
+// Counts
+// returns the distinct elements with their counts
+func (m MultiSet[T]) Counts() []Counted[T] {
+	result := make([]Counted[T], 0, len(m))
+	for v, c := range m {
+		result = append(result, Counted[T]{Value: v, Count: c})
+	}
+	return result
+}
+
+// MostCommon
+// returns up to `n` elements with the highest counts in descending order of count
+// (all elements if `n` is negative); the order of elements with equal counts is unspecified
+func (m MultiSet[T]) MostCommon(n int) []Counted[T] {
+	result := m.Counts()
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Count > result[j].Count
+	})
+	if n >= 0 && n < len(result) {
+		result = result[:n:n]
+	}
+	return result
+}
+
+// ToSet
+// returns a Set of the distinct elements
+func (m MultiSet[T]) ToSet() Set[T] {
+	s := make(Set[T], len(m))
+	for v := range m {
+		s[v] = struct{}{}
+	}
+	return s
+}
+
+// Union
+// sets the count of each element to the maximum of its counts in the MultiSet and the others
+func (m MultiSet[T]) Union(others ...MultiSet[T]) {
+	for i := range others {
+		for v, c := range others[i] {
+			if c > m[v] {
+				m[v] = c
+			}
+		}
+	}
+}
+
+// Sum
+// adds the counts of the other MultiSets
+func (m MultiSet[T]) Sum(others ...MultiSet[T]) {
+	counts := make(map[T]int)
+	for i := range others {
+		for v, c := range others[i] {
+			counts[v] += c
+		}
+	}
+	for v, c := range counts {
+		m[v] += c
+	}
+}
+
+// Intersect
+// sets the count of each element to the minimum of its counts in the MultiSet and the others
+func (m *MultiSet[T]) Intersect(others ...MultiSet[T]) {
+	if len(others) == 0 {
+		m.Truncate()
+		return
+	}
+
+	for v, c := range *m {
+		for i := range others {
+			if oc := others[i][v]; oc < c {
+				c = oc
+			}
+		}
+		if c <= 0 {
+			delete(*m, v)
+			continue
+		}
+		(*m)[v] = c
+	}
+}
+
+// Diff
+// subtracts the counts of the other MultiSets, removing elements whose count drops to zero
+func (m MultiSet[T]) Diff(others ...MultiSet[T]) {
+	counts := make(map[T]int)
+	for i := range others {
+		for v, c := range others[i] {
+			counts[v] += c
+		}
+	}
+	for v, c := range counts {
+		m.RemoveN(v, c)
+	}
+}
+
+// Equals
+// returns true if the MultiSets contain the same elements with the same counts
+func (m MultiSet[T]) Equals(other MultiSet[T]) bool {
+	if len(m) != len(other) {
+		return false
+	}
+
+	for v, c := range m {
+		if other[v] != c {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Copy
+// returns a copy of the MultiSet
+func (m MultiSet[T]) Copy() MultiSet[T] {
+	newSet := make(MultiSet[T], len(m))
+	for v, c := range m {
+		newSet[v] = c
+	}
+	return newSet
+}
@@ -0,0 +1,217 @@
+package sets
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+)
+
+func TestMakeMulti(t *testing.T) {
+	tests := []struct {
+		name   string
+		values []string
+		exp    MultiSet[string]
+	}{
+		{name: "empty", exp: MultiSet[string]{}},
+		{name: "counts", values: []string{"a", "b", "a", "c", "a"}, exp: MultiSet[string]{"a": 3, "b": 1, "c": 1}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := MakeMulti[string](tt.values...); !reflect.DeepEqual(got, tt.exp) {
+				t.Errorf(errorFormat, got, tt.exp)
+			}
+		})
+	}
+}
+
+func TestMultiFromSet(t *testing.T) {
+	exp := MultiSet[int]{1: 1, 2: 1, 3: 1, 4: 1, 5: 1}
+	if got := MultiFromSet(intSet); !reflect.DeepEqual(got, exp) {
+		t.Errorf(errorFormat, got, exp)
+	}
+}
+
+func TestMultiSet_AddN(t *testing.T) {
+	tests := []struct {
+		name string
+		n    int
+		exp  MultiSet[int]
+	}{
+		{name: "negative", n: -1, exp: MultiSet[int]{1: 1}},
+		{name: "zero", n: 0, exp: MultiSet[int]{1: 1}},
+		{name: "3", n: 3, exp: MultiSet[int]{1: 4}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := MakeMulti[int](1)
+			m.AddN(1, tt.n)
+			if !reflect.DeepEqual(m, tt.exp) {
+				t.Errorf(errorFormat, m, tt.exp)
+			}
+		})
+	}
+}
+
+func TestMultiSet_Remove(t *testing.T) {
+	m := MakeMulti[int](1, 1, 2)
+	m.Remove(1, 2, 3)
+	if exp := (MultiSet[int]{1: 1}); !reflect.DeepEqual(m, exp) {
+		t.Errorf(errorFormat, m, exp)
+	}
+}
+
+func TestMultiSet_RemoveN(t *testing.T) {
+	tests := []struct {
+		name string
+		n    int
+		exp  MultiSet[int]
+	}{
+		{name: "zero", n: 0, exp: MultiSet[int]{1: 3}},
+		{name: "some", n: 2, exp: MultiSet[int]{1: 1}},
+		{name: "exact", n: 3, exp: MultiSet[int]{}},
+		{name: "more", n: 5, exp: MultiSet[int]{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := MultiSet[int]{1: 3}
+			m.RemoveN(1, tt.n)
+			if !reflect.DeepEqual(m, tt.exp) {
+				t.Errorf(errorFormat, m, tt.exp)
+			}
+		})
+	}
+}
+
+func TestMultiSet_Delete(t *testing.T) {
+	m := MultiSet[int]{1: 3, 2: 1}
+	m.Delete(1)
+	if exp := (MultiSet[int]{2: 1}); !reflect.DeepEqual(m, exp) {
+		t.Errorf(errorFormat, m, exp)
+	}
+}
+
+func TestMultiSet_Truncate(t *testing.T) {
+	m := MultiSet[int]{1: 3}
+	m.Truncate()
+	if exp := (MultiSet[int]{}); !reflect.DeepEqual(m, exp) {
+		t.Errorf(errorFormat, m, exp)
+	}
+}
+
+func TestMultiSet_CountHasLenTotal(t *testing.T) {
+	m := MultiSet[string]{"a": 3, "b": 2}
+	got := []any{m.Count("a"), m.Count("none"), m.Has("b"), m.Has("none"), m.Len(), m.Total()}
+	exp := []any{3, 0, true, false, 2, 5}
+	if !reflect.DeepEqual(got, exp) {
+		t.Errorf(errorFormat, got, exp)
+	}
+}
+
+func TestMultiSet_Values(t *testing.T) {
+	got := MultiSet[int]{1: 2, 3: 1}.Values()
+	sort.Ints(got)
+	if exp := []int{1, 1, 3}; !reflect.DeepEqual(got, exp) {
+		t.Errorf(errorFormat, got, exp)
+	}
+}
+
+func TestMultiSet_MostCommon(t *testing.T) {
+	m := MultiSet[string]{"a": 1, "b": 5, "c": 3}
+	tests := []struct {
+		name string
+		n    int
+		exp  []Counted[string]
+	}{
+		{name: "zero", n: 0, exp: []Counted[string]{}},
+		{name: "two", n: 2, exp: []Counted[string]{{"b", 5}, {"c", 3}}},
+		{name: "all", n: -1, exp: []Counted[string]{{"b", 5}, {"c", 3}, {"a", 1}}},
+		{name: "more", n: 10, exp: []Counted[string]{{"b", 5}, {"c", 3}, {"a", 1}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := m.MostCommon(tt.n); !reflect.DeepEqual(got, tt.exp) {
+				t.Errorf(errorFormat, got, tt.exp)
+			}
+		})
+	}
+}
+
+func TestMultiSet_ToSet(t *testing.T) {
+	if got, exp := (MultiSet[int]{1: 3, 2: 1}).ToSet(), Make[int](1, 2); !reflect.DeepEqual(got, exp) {
+		t.Errorf(errorFormat, got, exp)
+	}
+}
+
+func TestMultiSet_Union(t *testing.T) {
+	m := MultiSet[string]{"a": 2, "b": 1}
+	m.Union(MultiSet[string]{"a": 1, "b": 3}, MultiSet[string]{"c": 2})
+	if exp := (MultiSet[string]{"a": 2, "b": 3, "c": 2}); !reflect.DeepEqual(m, exp) {
+		t.Errorf(errorFormat, m, exp)
+	}
+}
+
+func TestMultiSet_Sum(t *testing.T) {
+	m := MultiSet[string]{"a": 2, "b": 1}
+	m.Sum(MultiSet[string]{"a": 1, "b": 3}, MultiSet[string]{"c": 2}, m)
+	if exp := (MultiSet[string]{"a": 5, "b": 5, "c": 2}); !reflect.DeepEqual(m, exp) {
+		t.Errorf(errorFormat, m, exp)
+	}
+}
+
+func TestMultiSet_Intersect(t *testing.T) {
+	tests := []struct {
+		name   string
+		others []MultiSet[string]
+		exp    MultiSet[string]
+	}{
+		{name: "empty_others", exp: MultiSet[string]{}},
+		{name: "min", others: []MultiSet[string]{{"a": 1, "b": 5}, {"a": 4, "b": 2, "c": 1}}, exp: MultiSet[string]{"a": 1, "b": 2}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := MultiSet[string]{"a": 3, "b": 3, "d": 1}
+			m.Intersect(tt.others...)
+			if !reflect.DeepEqual(m, tt.exp) {
+				t.Errorf(errorFormat, m, tt.exp)
+			}
+		})
+	}
+}
+
+func TestMultiSet_Diff(t *testing.T) {
+	m := MultiSet[string]{"a": 3, "b": 3, "c": 1}
+	m.Diff(MultiSet[string]{"a": 1, "b": 5}, MultiSet[string]{"a": 1, "d": 1})
+	if exp := (MultiSet[string]{"a": 1, "c": 1}); !reflect.DeepEqual(m, exp) {
+		t.Errorf(errorFormat, m, exp)
+	}
+}
+
+func TestMultiSet_Equals(t *testing.T) {
+	tests := []struct {
+		name  string
+		m     MultiSet[int]
+		other MultiSet[int]
+		exp   bool
+	}{
+		{name: "empty", exp: true},
+		{name: "equals", m: MultiSet[int]{1: 2}, other: MultiSet[int]{1: 2}, exp: true},
+		{name: "counts_differ", m: MultiSet[int]{1: 2}, other: MultiSet[int]{1: 1}, exp: false},
+		{name: "len_differ", m: MultiSet[int]{1: 2}, other: MultiSet[int]{1: 2, 2: 1}, exp: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.m.Equals(tt.other); got != tt.exp {
+				t.Errorf(errorFormat, got, tt.exp)
+			}
+		})
+	}
+}
+
+func TestMultiSet_Copy(t *testing.T) {
+	m := MultiSet[int]{1: 2}
+	c := m.Copy()
+	m.Add(1)
+	if exp := (MultiSet[int]{1: 2}); !reflect.DeepEqual(c, exp) {
+		t.Errorf(errorFormat, c, exp)
+	}
+}
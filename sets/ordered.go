@@ -0,0 +1,300 @@
+package sets
+
+// OrderedSet represents a set of elements of type T that remembers insertion order.
+// Has, Add and Delete are O(1); Values and iteration return elements in the order
+// they were first added.
+type OrderedSet[T comparable] struct {
+	nodes map[T]*orderedNode[T]
+	head  *orderedNode[T]
+	tail  *orderedNode[T]
+}
+
+type orderedNode[T comparable] struct {
+	value      T
+	prev, next *orderedNode[T]
+	removed    bool
+}
+
+// MakeOrdered
+// creates a new OrderedSet of type T; duplicates keep the position of their first occurrence
+func MakeOrdered[T comparable](values ...T) *OrderedSet[T] {
+	s := &OrderedSet[T]{nodes: make(map[T]*orderedNode[T], len(values))}
+	s.Add(values...)
+	return s
+}
+
+func (s *OrderedSet[T]) pushBack(n *orderedNode[T]) {
+	n.prev, n.next = s.tail, nil
+	if s.tail != nil {
+		s.tail.next = n
+	} else {
+		s.head = n
+	}
+	s.tail = n
+}
+
+func (s *OrderedSet[T]) unlink(n *orderedNode[T]) {
+	if n.prev != nil {
+		n.prev.next = n.next
+	} else {
+		s.head = n.next
+	}
+	if n.next != nil {
+		n.next.prev = n.prev
+	} else {
+		s.tail = n.prev
+	}
+}
+
+// remove deletes the node from the Set; a removed node keeps its next pointer,
+// so an iteration standing on it can continue to the following nodes
+func (s *OrderedSet[T]) remove(n *orderedNode[T]) {
+	s.unlink(n)
+	delete(s.nodes, n.value)
+	n.removed = true
+}
+
+// Add
+// appends values that are not in the Set yet to the end of the Set
+func (s *OrderedSet[T]) Add(values ...T) {
+	if s.nodes == nil {
+		s.nodes = make(map[T]*orderedNode[T], len(values))
+	}
+	for _, v := range values {
+		if _, e := s.nodes[v]; e {
+			continue
+		}
+		n := &orderedNode[T]{value: v}
+		s.nodes[v] = n
+		s.pushBack(n)
+	}
+}
+
+// Delete
+// deletes values from the Set
+func (s *OrderedSet[T]) Delete(values ...T) {
+	for _, v := range values {
+		if n, e := s.nodes[v]; e {
+			s.remove(n)
+		}
+	}
+}
+
+// Truncate
+// deletes all values from the Set
+func (s *OrderedSet[T]) Truncate() {
+	for n := s.head; n != nil; n = n.next {
+		n.removed = true
+	}
+	s.nodes = make(map[T]*orderedNode[T])
+	s.head, s.tail = nil, nil
+}
+
+// Has
+// returns true if Set contains the value or false if not
+func (s *OrderedSet[T]) Has(value T) bool {
+	_, e := s.nodes[value]
+	return e
+}
+
+// Len
+// returns the length of the Set
+func (s *OrderedSet[T]) Len() int {
+	return len(s.nodes)
+}
+
+// Values
+// returns the Set values in insertion order
+func (s *OrderedSet[T]) Values() []T {
+	values := make([]T, 0, len(s.nodes))
+	for n := s.head; n != nil; n = n.next {
+		values = append(values, n.value)
+	}
+	return values
+}
+
+// Each
+// calls the func for each element in insertion order until the func returns false;
+// the func may delete elements, deleted elements are not visited afterwards.
+// Elements added or moved by the func may be skipped or visited twice
+func (s *OrderedSet[T]) Each(f func(T) bool) {
+	for n := s.head; n != nil; n = n.next {
+		if n.removed {
+			continue
+		}
+		if !f(n.value) {
+			return
+		}
+	}
+}
+
+// Merge
+// appends values of the other Sets in their order
+func (s *OrderedSet[T]) Merge(others ...*OrderedSet[T]) {
+	for i := range others {
+		if others[i] == s {
+			continue
+		}
+		for n := others[i].head; n != nil; n = n.next {
+			s.Add(n.value)
+		}
+	}
+}
+
+// Diff
+// removes all values represented in any of the other Sets, keeping the order of the rest
+func (s *OrderedSet[T]) Diff(others ...*OrderedSet[T]) {
+	for i := range others {
+		if others[i] == s {
+			s.Truncate()
+			return
+		}
+	}
+
+	s.Filter(func(v T) bool {
+		for i := range others {
+			if others[i].Has(v) {
+				return false
+			}
+		}
+		return true
+	})
+}
+
+// Intersect
+// removes all values not represented in all others Sets, keeping the order of the rest
+func (s *OrderedSet[T]) Intersect(others ...*OrderedSet[T]) {
+	if len(others) == 0 {
+		s.Truncate()
+		return
+	}
+
+	s.Filter(func(v T) bool {
+		for i := range others {
+			if !others[i].Has(v) {
+				return false
+			}
+		}
+		return true
+	})
+}
+
+// Equals
+// returns true if the Sets contain the same elements, regardless of order
+func (s *OrderedSet[T]) Equals(other *OrderedSet[T]) bool {
+	if s.Len() != other.Len() {
+		return false
+	}
+
+	for v := range s.nodes {
+		if !other.Has(v) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Filter
+// removes elements for which the func returns false
+func (s *OrderedSet[T]) Filter(f func(T) bool) {
+	for n := s.head; n != nil; {
+		next := n.next
+		if !f(n.value) {
+			s.remove(n)
+		}
+		n = next
+	}
+}
+
+// Map
+// calls the func for each element; results keep the order of their first occurrence
+func (s *OrderedSet[T]) Map(f func(T) T) {
+	values := make([]T, 0, s.Len())
+	for n := s.head; n != nil; n = n.next {
+		values = append(values, f(n.value))
+	}
+	s.Truncate()
+	s.Add(values...)
+}
+
+// Copy
+// returns a copy of the Set
+func (s *OrderedSet[T]) Copy() *OrderedSet[T] {
+	return MakeOrdered[T](s.Values()...)
+}
+
+// ToSet
+// returns the Set values as an unordered Set
+func (s *OrderedSet[T]) ToSet() Set[T] {
+	result := make(Set[T], len(s.nodes))
+	for v := range s.nodes {
+		result[v] = struct{}{}
+	}
+	return result
+}
+
+// First
+// returns the first element and true, or the zero value and false if the Set is empty
+func (s *OrderedSet[T]) First() (T, bool) {
+	if s.head == nil {
+		return *new(T), false
+	}
+	return s.head.value, true
+}
+
+// Last
+// returns the last element and true, or the zero value and false if the Set is empty
+func (s *OrderedSet[T]) Last() (T, bool) {
+	if s.tail == nil {
+		return *new(T), false
+	}
+	return s.tail.value, true
+}
+
+// IndexOf
+// returns position of the value or -1 if the value is not found (O(n))
+func (s *OrderedSet[T]) IndexOf(value T) int {
+	if !s.Has(value) {
+		return -1
+	}
+	i := 0
+	for n := s.head; n != nil; n = n.next {
+		if n.value == value {
+			return i
+		}
+		i++
+	}
+	return -1
+}
+
+// MoveToFront
+// moves the value to the first position; returns false if the value is not found
+func (s *OrderedSet[T]) MoveToFront(value T) bool {
+	n, e := s.nodes[value]
+	if !e {
+		return false
+	}
+	if n == s.head {
+		return true
+	}
+	s.unlink(n)
+	n.prev, n.next = nil, s.head
+	s.head.prev = n
+	s.head = n
+	return true
+}
+
+// MoveToBack
+// moves the value to the last position; returns false if the value is not found
+func (s *OrderedSet[T]) MoveToBack(value T) bool {
+	n, e := s.nodes[value]
+	if !e {
+		return false
+	}
+	if n != s.tail {
+		s.unlink(n)
+		s.pushBack(n)
+	}
+	return true
+}
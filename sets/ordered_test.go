@@ -0,0 +1,330 @@
+package sets
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestMakeOrdered(t *testing.T) {
+	tests := []struct {
+		name   string
+		values []string
+		exp    []string
+	}{
+		{name: "empty", exp: []string{}},
+		{name: "order", values: []string{"c", "a", "b"}, exp: []string{"c", "a", "b"}},
+		{name: "duplicates", values: []string{"c", "a", "c", "b", "a"}, exp: []string{"c", "a", "b"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := MakeOrdered[string](tt.values...).Values(); !reflect.DeepEqual(got, tt.exp) {
+				t.Errorf(errorFormat, got, tt.exp)
+			}
+		})
+	}
+}
+
+func TestOrderedSet_ZeroValue(t *testing.T) {
+	var s OrderedSet[int]
+	s.Add(3, 1, 2)
+	if got, exp := s.Values(), []int{3, 1, 2}; !reflect.DeepEqual(got, exp) {
+		t.Errorf(errorFormat, got, exp)
+	}
+}
+
+func TestOrderedSet_Delete(t *testing.T) {
+	tests := []struct {
+		name string
+		del  []int
+		exp  []int
+	}{
+		{name: "nothing", exp: []int{1, 2, 3, 4, 5}},
+		{name: "head", del: []int{1}, exp: []int{2, 3, 4, 5}},
+		{name: "tail", del: []int{5}, exp: []int{1, 2, 3, 4}},
+		{name: "middle_and_missing", del: []int{3, 42}, exp: []int{1, 2, 4, 5}},
+		{name: "all", del: []int{5, 4, 3, 2, 1}, exp: []int{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := MakeOrdered[int](1, 2, 3, 4, 5)
+			s.Delete(tt.del...)
+			if got := s.Values(); !reflect.DeepEqual(got, tt.exp) {
+				t.Errorf(errorFormat, got, tt.exp)
+			}
+			if got := s.Len(); got != len(tt.exp) {
+				t.Errorf(errorFormat, got, len(tt.exp))
+			}
+		})
+	}
+}
+
+func TestOrderedSet_Truncate(t *testing.T) {
+	s := MakeOrdered[int](1, 2, 3)
+	s.Truncate()
+	s.Add(4)
+	if got, exp := s.Values(), []int{4}; !reflect.DeepEqual(got, exp) {
+		t.Errorf(errorFormat, got, exp)
+	}
+}
+
+func TestOrderedSet_Has(t *testing.T) {
+	s := MakeOrdered[string]("one", "two")
+	if !s.Has("one") || s.Has("none") {
+		t.Errorf(errorFormat, []bool{s.Has("one"), s.Has("none")}, []bool{true, false})
+	}
+}
+
+func TestOrderedSet_Each(t *testing.T) {
+	s := MakeOrdered[int](5, 4, 3, 2, 1)
+	got := make([]int, 0)
+	s.Each(func(i int) bool {
+		got = append(got, i)
+		return i != 3
+	})
+	if exp := []int{5, 4, 3}; !reflect.DeepEqual(got, exp) {
+		t.Errorf(errorFormat, got, exp)
+	}
+}
+
+func TestOrderedSet_EachDelete(t *testing.T) {
+	tests := []struct {
+		name   string
+		at     int
+		delete []int
+		exp    []int
+		values []int
+	}{
+		{name: "next", at: 1, delete: []int{2}, exp: []int{1, 3, 4}, values: []int{1, 3, 4}},
+		{name: "current", at: 2, delete: []int{2}, exp: []int{1, 2, 3, 4}, values: []int{1, 3, 4}},
+		{name: "current and next", at: 2, delete: []int{2, 3}, exp: []int{1, 2, 4}, values: []int{1, 4}},
+		{name: "previous", at: 3, delete: []int{1, 2}, exp: []int{1, 2, 3, 4}, values: []int{3, 4}},
+		{name: "rest", at: 1, delete: []int{2, 3, 4}, exp: []int{1}, values: []int{1}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := MakeOrdered[int](1, 2, 3, 4)
+			got := make([]int, 0)
+			s.Each(func(i int) bool {
+				got = append(got, i)
+				if i == tt.at {
+					s.Delete(tt.delete...)
+				}
+				return true
+			})
+			if !reflect.DeepEqual(got, tt.exp) {
+				t.Errorf(errorFormat, got, tt.exp)
+			}
+			if !reflect.DeepEqual(s.Values(), tt.values) {
+				t.Errorf(errorFormat, s.Values(), tt.values)
+			}
+		})
+	}
+
+	s := MakeOrdered[int](1, 2, 3)
+	got := make([]int, 0)
+	s.Each(func(i int) bool {
+		got = append(got, i)
+		s.Truncate()
+		return true
+	})
+	if exp := []int{1}; !reflect.DeepEqual(got, exp) || s.Len() != 0 {
+		t.Errorf(errorFormat, got, exp)
+	}
+}
+
+func TestOrderedSet_Merge(t *testing.T) {
+	s := MakeOrdered[int](3, 1)
+	s.Merge(MakeOrdered[int](2, 1, 5), MakeOrdered[int](4, 5), s)
+	if got, exp := s.Values(), []int{3, 1, 2, 5, 4}; !reflect.DeepEqual(got, exp) {
+		t.Errorf(errorFormat, got, exp)
+	}
+}
+
+func TestOrderedSet_Diff(t *testing.T) {
+	tests := []struct {
+		name   string
+		others []*OrderedSet[int]
+		exp    []int
+	}{
+		{name: "empty_others", exp: []int{5, 1, 4, 2, 3}},
+		{name: "diff", others: []*OrderedSet[int]{MakeOrdered[int](4), MakeOrdered[int](1, 9)}, exp: []int{5, 2, 3}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := MakeOrdered[int](5, 1, 4, 2, 3)
+			s.Diff(tt.others...)
+			if got := s.Values(); !reflect.DeepEqual(got, tt.exp) {
+				t.Errorf(errorFormat, got, tt.exp)
+			}
+		})
+	}
+}
+
+func TestOrderedSet_Intersect(t *testing.T) {
+	tests := []struct {
+		name   string
+		others []*OrderedSet[int]
+		exp    []int
+	}{
+		{name: "empty_others", exp: []int{}},
+		{name: "intersect", others: []*OrderedSet[int]{MakeOrdered[int](1, 2, 3, 4), MakeOrdered[int](4, 3, 2)}, exp: []int{4, 2, 3}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := MakeOrdered[int](5, 1, 4, 2, 3)
+			s.Intersect(tt.others...)
+			if got := s.Values(); !reflect.DeepEqual(got, tt.exp) {
+				t.Errorf(errorFormat, got, tt.exp)
+			}
+		})
+	}
+}
+
+func TestOrderedSet_Equals(t *testing.T) {
+	tests := []struct {
+		name  string
+		set   *OrderedSet[int]
+		other *OrderedSet[int]
+		exp   bool
+	}{
+		{name: "empty", set: MakeOrdered[int](), other: MakeOrdered[int](), exp: true},
+		{name: "different_order", set: MakeOrdered[int](1, 2), other: MakeOrdered[int](2, 1), exp: true},
+		{name: "not_equals", set: MakeOrdered[int](1, 2), other: MakeOrdered[int](1, 3), exp: false},
+		{name: "not_equals_len", set: MakeOrdered[int](1, 2), other: MakeOrdered[int](1), exp: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.set.Equals(tt.other); got != tt.exp {
+				t.Errorf(errorFormat, got, tt.exp)
+			}
+		})
+	}
+}
+
+func TestOrderedSet_Filter(t *testing.T) {
+	s := MakeOrdered[int](5, 1, 4, 2, 3)
+	s.Filter(func(i int) bool { return i%2 == 1 })
+	if got, exp := s.Values(), []int{5, 1, 3}; !reflect.DeepEqual(got, exp) {
+		t.Errorf(errorFormat, got, exp)
+	}
+}
+
+func TestOrderedSet_Map(t *testing.T) {
+	s := MakeOrdered[int](3, 1, 4, 2)
+	s.Map(func(i int) int { return i / 2 })
+	if got, exp := s.Values(), []int{1, 0, 2}; !reflect.DeepEqual(got, exp) {
+		t.Errorf(errorFormat, got, exp)
+	}
+}
+
+func TestOrderedSet_Copy(t *testing.T) {
+	s := MakeOrdered[string]("b", "a")
+	c := s.Copy()
+	s.Add("c")
+	if got, exp := c.Values(), []string{"b", "a"}; !reflect.DeepEqual(got, exp) {
+		t.Errorf(errorFormat, got, exp)
+	}
+}
+
+func TestOrderedSet_ToSet(t *testing.T) {
+	if got, exp := MakeOrdered[int](5, 4, 3, 2, 1).ToSet(), intSet; !reflect.DeepEqual(got, exp) {
+		t.Errorf(errorFormat, got, exp)
+	}
+}
+
+func TestOrderedSet_FirstLast(t *testing.T) {
+	tests := []struct {
+		name     string
+		set      *OrderedSet[int]
+		first    int
+		last     int
+		nonEmpty bool
+	}{
+		{name: "empty", set: MakeOrdered[int]()},
+		{name: "one", set: MakeOrdered[int](7), first: 7, last: 7, nonEmpty: true},
+		{name: "many", set: MakeOrdered[int](3, 1, 2), first: 3, last: 2, nonEmpty: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			first, ok1 := tt.set.First()
+			last, ok2 := tt.set.Last()
+			got := []any{first, last, ok1, ok2}
+			exp := []any{tt.first, tt.last, tt.nonEmpty, tt.nonEmpty}
+			if !reflect.DeepEqual(got, exp) {
+				t.Errorf(errorFormat, got, exp)
+			}
+		})
+	}
+}
+
+func TestOrderedSet_IndexOf(t *testing.T) {
+	tests := []struct {
+		name string
+		val  string
+		exp  int
+	}{
+		{name: "missing", val: "none", exp: -1},
+		{name: "first", val: "c", exp: 0},
+		{name: "last", val: "b", exp: 2},
+	}
+	s := MakeOrdered[string]("c", "a", "b")
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := s.IndexOf(tt.val); got != tt.exp {
+				t.Errorf(errorFormat, got, tt.exp)
+			}
+		})
+	}
+}
+
+func TestOrderedSet_MoveToFront(t *testing.T) {
+	tests := []struct {
+		name  string
+		val   int
+		found bool
+		exp   []int
+	}{
+		{name: "missing", val: 42, exp: []int{1, 2, 3}},
+		{name: "head", val: 1, found: true, exp: []int{1, 2, 3}},
+		{name: "middle", val: 2, found: true, exp: []int{2, 1, 3}},
+		{name: "tail", val: 3, found: true, exp: []int{3, 1, 2}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := MakeOrdered[int](1, 2, 3)
+			if got := s.MoveToFront(tt.val); got != tt.found {
+				t.Errorf(errorFormat, got, tt.found)
+			}
+			if got := s.Values(); !reflect.DeepEqual(got, tt.exp) {
+				t.Errorf(errorFormat, got, tt.exp)
+			}
+			if last, _ := s.Last(); last != tt.exp[2] {
+				t.Errorf(errorFormat, last, tt.exp[2])
+			}
+		})
+	}
+}
+
+func TestOrderedSet_MoveToBack(t *testing.T) {
+	tests := []struct {
+		name  string
+		val   int
+		found bool
+		exp   []int
+	}{
+		{name: "missing", val: 42, exp: []int{1, 2, 3}},
+		{name: "head", val: 1, found: true, exp: []int{2, 3, 1}},
+		{name: "tail", val: 3, found: true, exp: []int{1, 2, 3}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := MakeOrdered[int](1, 2, 3)
+			if got := s.MoveToBack(tt.val); got != tt.found {
+				t.Errorf(errorFormat, got, tt.found)
+			}
+			if got := s.Values(); !reflect.DeepEqual(got, tt.exp) {
+				t.Errorf(errorFormat, got, tt.exp)
+			}
+		})
+	}
+}
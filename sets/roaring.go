@@ -0,0 +1,877 @@
+package sets
+
+import (
+	"encoding/binary"
+	"errors"
+	"math/bits"
+	"sort"
+)
+
+const (
+	// cookies of the portable Roaring format without and with run containers
+	roaringCookie     = 12346
+	roaringCookieRuns = 12347
+	// the run format omits container offsets for fewer containers than this
+	roaringOffsetsMin = 4
+	arrayMaxCard      = 4096
+	bitmapWords       = 1 << 16 / 64
+)
+
+// ErrInvalidRoaring is returned when decoding malformed Roaring data
+var ErrInvalidRoaring = errors.New("sets: invalid Roaring data")
+
+// Roaring represents a compressed set of uint32 values (a roaring bitmap).
+// Values are grouped by their high 16 bits into containers that store the low 16 bits
+// as a sorted array (sparse), a bitmap (dense) or a list of runs (consecutive ranges),
+// whichever fits the data. The zero value is an empty set.
+type Roaring struct {
+	keys       []uint16
+	containers []container
+}
+
+type container interface {
+	add(v uint16) container
+	remove(v uint16) container
+	contains(v uint16) bool
+	card() int
+	// rank returns the number of values less than v
+	rank(v uint16) int
+	selectAt(i int) uint16
+	each(f func(uint16) bool) bool
+	toBitmap() *bitmapContainer
+	clone() container
+}
+
+// MakeRoaring
+// creates a new Roaring set containing the values
+func MakeRoaring(values ...uint32) *Roaring {
+	r := &Roaring{}
+	r.Add(values...)
+	return r
+}
+
+// RoaringFromSet
+// creates a new Roaring set containing the values of the Set
+func RoaringFromSet(s Set[uint32]) *Roaring {
+	values := s.Values()
+	sort.Slice(values, func(i, j int) bool { return values[i] < values[j] })
+	return MakeRoaring(values...)
+}
+
+func (r *Roaring) index(key uint16) (int, bool) {
+	i := sort.Search(len(r.keys), func(i int) bool { return r.keys[i] >= key })
+	return i, i < len(r.keys) && r.keys[i] == key
+}
+
+// Add
+// adds values to the set
+func (r *Roaring) Add(values ...uint32) {
+	for _, v := range values {
+		key, low := uint16(v>>16), uint16(v)
+		i, found := r.index(key)
+		if !found {
+			r.keys = append(r.keys, 0)
+			copy(r.keys[i+1:], r.keys[i:])
+			r.keys[i] = key
+			r.containers = append(r.containers, nil)
+			copy(r.containers[i+1:], r.containers[i:])
+			r.containers[i] = arrayContainer{low}
+			continue
+		}
+		r.containers[i] = r.containers[i].add(low)
+	}
+}
+
+// Delete
+// deletes values from the set
+func (r *Roaring) Delete(values ...uint32) {
+	for _, v := range values {
+		i, found := r.index(uint16(v >> 16))
+		if !found {
+			continue
+		}
+		c := r.containers[i].remove(uint16(v))
+		if c.card() > 0 {
+			r.containers[i] = c
+			continue
+		}
+		r.keys = append(r.keys[:i], r.keys[i+1:]...)
+		r.containers = append(r.containers[:i], r.containers[i+1:]...)
+	}
+}
+
+// Truncate
+// deletes all values from the set
+func (r *Roaring) Truncate() {
+	r.keys, r.containers = nil, nil
+}
+
+// Has
+// returns true if the set contains the value or false if not
+func (r *Roaring) Has(value uint32) bool {
+	i, found := r.index(uint16(value >> 16))
+	return found && r.containers[i].contains(uint16(value))
+}
+
+// Len
+// returns the number of values in the set
+func (r *Roaring) Len() int {
+	n := 0
+	for _, c := range r.containers {
+		n += c.card()
+	}
+	return n
+}
+
+// Values
+// returns the set values in ascending order
+func (r *Roaring) Values() []uint32 {
+	values := make([]uint32, 0, r.Len())
+	r.Each(func(v uint32) bool {
+		values = append(values, v)
+		return true
+	})
+	return values
+}
+
+// Each
+// calls the func for each value in ascending order until the func returns false
+func (r *Roaring) Each(f func(uint32) bool) {
+	for i, c := range r.containers {
+		high := uint32(r.keys[i]) << 16
+		if !c.each(func(low uint16) bool { return f(high | uint32(low)) }) {
+			return
+		}
+	}
+}
+
+// ToSet
+// returns the set values as a Set
+func (r *Roaring) ToSet() Set[uint32] {
+	s := make(Set[uint32], r.Len())
+	r.Each(func(v uint32) bool {
+		s[v] = struct{}{}
+		return true
+	})
+	return s
+}
+
+// Copy
+// returns a copy of the set
+func (r *Roaring) Copy() *Roaring {
+	c := &Roaring{keys: make([]uint16, len(r.keys)), containers: make([]container, len(r.containers))}
+	copy(c.keys, r.keys)
+	for i := range r.containers {
+		c.containers[i] = r.containers[i].clone()
+	}
+	return c
+}
+
+// Equals
+// returns true if the sets are equal to each other
+func (r *Roaring) Equals(other *Roaring) bool {
+	if len(r.keys) != len(other.keys) {
+		return false
+	}
+	for i := range r.keys {
+		a, b := r.containers[i], other.containers[i]
+		if r.keys[i] != other.keys[i] || a.card() != b.card() {
+			return false
+		}
+		if !a.each(b.contains) {
+			return false
+		}
+	}
+	return true
+}
+
+// Rank
+// returns the number of values strictly less than the value
+func (r *Roaring) Rank(value uint32) int {
+	key := uint16(value >> 16)
+	n := 0
+	for i, k := range r.keys {
+		switch {
+		case k < key:
+			n += r.containers[i].card()
+		case k == key:
+			return n + r.containers[i].rank(uint16(value))
+		default:
+			return n
+		}
+	}
+	return n
+}
+
+// Select
+// returns the value with the given zero-based rank, and false if k is out of range
+func (r *Roaring) Select(k int) (uint32, bool) {
+	if k < 0 {
+		return 0, false
+	}
+	for i, c := range r.containers {
+		if k < c.card() {
+			return uint32(r.keys[i])<<16 | uint32(c.selectAt(k)), true
+		}
+		k -= c.card()
+	}
+	return 0, false
+}
+
+// And
+// returns a new set containing the values represented in both sets
+func (r *Roaring) And(other *Roaring) *Roaring {
+	return r.combine(other, containerAnd, false, false)
+}
+
+// Or
+// returns a new set containing the values represented in either set
+func (r *Roaring) Or(other *Roaring) *Roaring {
+	return r.combine(other, containerOr, true, true)
+}
+
+// AndNot
+// returns a new set containing the values of the set that are not represented in the other set
+func (r *Roaring) AndNot(other *Roaring) *Roaring {
+	return r.combine(other, containerAndNot, true, false)
+}
+
+// Xor
+// returns a new set containing the values represented in exactly one of the sets
+func (r *Roaring) Xor(other *Roaring) *Roaring {
+	return r.combine(other, containerXor, true, true)
+}
+
+// combine walks both key lists; keepLeft and keepRight tell whether containers
+// present on only one side are copied into the result
+func (r *Roaring) combine(other *Roaring, op func(a, b container) container, keepLeft, keepRight bool) *Roaring {
+	result := &Roaring{}
+	i, j := 0, 0
+	for i < len(r.keys) || j < len(other.keys) {
+		switch {
+		case j == len(other.keys) || (i < len(r.keys) && r.keys[i] < other.keys[j]):
+			if keepLeft {
+				result.push(r.keys[i], r.containers[i].clone())
+			}
+			i++
+		case i == len(r.keys) || other.keys[j] < r.keys[i]:
+			if keepRight {
+				result.push(other.keys[j], other.containers[j].clone())
+			}
+			j++
+		default:
+			if c := op(r.containers[i], other.containers[j]); c != nil && c.card() > 0 {
+				result.push(r.keys[i], c)
+			}
+			i++
+			j++
+		}
+	}
+	return result
+}
+
+func (r *Roaring) push(key uint16, c container) {
+	r.keys = append(r.keys, key)
+	r.containers = append(r.containers, c)
+}
+
+// RunOptimize
+// converts each container to the smallest of the array, bitmap and run encodings
+func (r *Roaring) RunOptimize() {
+	for i, c := range r.containers {
+		runs := toRuns(c)
+		card := c.card()
+		size := 8 * bitmapWords
+		if card <= arrayMaxCard {
+			size = 2 * card
+		}
+		switch {
+		case 4*len(runs) < size:
+			r.containers[i] = runs
+		case card <= arrayMaxCard:
+			r.containers[i] = toArray(c)
+		default:
+			r.containers[i] = c.toBitmap()
+		}
+	}
+}
+
+// MarshalBinary
+// implements encoding.BinaryMarshaler using the portable Roaring format
+// (https://github.com/RoaringBitmap/RoaringFormatSpec), so the data can be read
+// by other Roaring implementations such as CRoaring, RoaringBitmap (Java) or roaring (Go)
+func (r *Roaring) MarshalBinary() ([]byte, error) {
+	n := len(r.containers)
+	hasRuns := false
+	for _, c := range r.containers {
+		if _, ok := c.(runContainer); ok {
+			hasRuns = true
+			break
+		}
+	}
+
+	data := make([]byte, 0, 8+8*n+r.Len()*2)
+	if hasRuns {
+		data = appendUint32(data, roaringCookieRuns|uint32(n-1)<<16)
+		flags := make([]byte, (n+7)/8)
+		for i, c := range r.containers {
+			if _, ok := c.(runContainer); ok {
+				flags[i/8] |= 1 << (i % 8)
+			}
+		}
+		data = append(data, flags...)
+	} else {
+		data = appendUint32(data, roaringCookie)
+		data = appendUint32(data, uint32(n))
+	}
+	for i, c := range r.containers {
+		data = appendUint16(appendUint16(data, r.keys[i]), uint16(c.card()-1))
+	}
+	offsets := -1
+	if !hasRuns || n >= roaringOffsetsMin {
+		offsets = len(data)
+		data = append(data, make([]byte, 4*n)...)
+	}
+
+	for i, c := range r.containers {
+		if offsets >= 0 {
+			binary.LittleEndian.PutUint32(data[offsets+4*i:], uint32(len(data)))
+		}
+		switch c := c.(type) {
+		case runContainer:
+			data = appendUint16(data, uint16(len(c)))
+			for _, run := range c {
+				data = appendUint16(appendUint16(data, run.start), run.last-run.start)
+			}
+		default:
+			if c.card() <= arrayMaxCard {
+				for _, v := range toArray(c) {
+					data = appendUint16(data, v)
+				}
+				continue
+			}
+			for _, w := range c.toBitmap().words {
+				data = appendUint64(data, w)
+			}
+		}
+	}
+	return data, nil
+}
+
+// UnmarshalBinary
+// implements encoding.BinaryUnmarshaler for the portable Roaring format, replacing the set contents
+func (r *Roaring) UnmarshalBinary(data []byte) error {
+	if len(data) < 4 {
+		return ErrInvalidRoaring
+	}
+
+	var n, pos int
+	var runFlags []byte
+	switch cookie := binary.LittleEndian.Uint32(data); {
+	case cookie == roaringCookie:
+		if len(data) < 8 {
+			return ErrInvalidRoaring
+		}
+		n, pos = int(binary.LittleEndian.Uint32(data[4:])), 8
+		if n > 1<<16 {
+			return ErrInvalidRoaring
+		}
+	case cookie&0xffff == roaringCookieRuns:
+		n = int(cookie>>16) + 1
+		pos = 4 + (n+7)/8
+		if len(data) < pos {
+			return ErrInvalidRoaring
+		}
+		runFlags = data[4:pos]
+	default:
+		return ErrInvalidRoaring
+	}
+
+	header := pos
+	pos += 4 * n
+	offsets := -1
+	if runFlags == nil || n >= roaringOffsetsMin {
+		offsets = pos
+		pos += 4 * n
+	}
+	if len(data) < pos {
+		return ErrInvalidRoaring
+	}
+
+	result := Roaring{}
+	for i := 0; i < n; i++ {
+		key := binary.LittleEndian.Uint16(data[header+4*i:])
+		card := int(binary.LittleEndian.Uint16(data[header+4*i+2:])) + 1
+		if len(result.keys) > 0 && key <= result.keys[len(result.keys)-1] {
+			return ErrInvalidRoaring
+		}
+		if offsets >= 0 && int(binary.LittleEndian.Uint32(data[offsets+4*i:])) != pos {
+			return ErrInvalidRoaring
+		}
+
+		isRun := runFlags != nil && runFlags[i/8]&(1<<(i%8)) != 0
+		c, size, err := decodeContainer(data[pos:], card, isRun)
+		if err != nil {
+			return err
+		}
+		result.push(key, c)
+		pos += size
+	}
+	if pos != len(data) {
+		return ErrInvalidRoaring
+	}
+
+	*r = result
+	return nil
+}
+
+// decodeContainer decodes a container with the given cardinality from the start of data
+// and returns it along with the number of bytes it occupies
+func decodeContainer(data []byte, card int, isRun bool) (container, int, error) {
+	switch {
+	case isRun:
+		if len(data) < 2 {
+			return nil, 0, ErrInvalidRoaring
+		}
+		count := int(binary.LittleEndian.Uint16(data))
+		size := 2 + 4*count
+		if count == 0 || len(data) < size {
+			return nil, 0, ErrInvalidRoaring
+		}
+		runs := make(runContainer, count)
+		for i := range runs {
+			start, length := binary.LittleEndian.Uint16(data[2+4*i:]), binary.LittleEndian.Uint16(data[4+4*i:])
+			if int(start)+int(length) > 0xffff || (i > 0 && start <= runs[i-1].last) {
+				return nil, 0, ErrInvalidRoaring
+			}
+			runs[i] = run{start: start, last: start + length}
+		}
+		if runs.card() != card {
+			return nil, 0, ErrInvalidRoaring
+		}
+		return runs, size, nil
+	case card <= arrayMaxCard:
+		size := 2 * card
+		if len(data) < size {
+			return nil, 0, ErrInvalidRoaring
+		}
+		a := make(arrayContainer, card)
+		for i := range a {
+			a[i] = binary.LittleEndian.Uint16(data[2*i:])
+			if i > 0 && a[i] <= a[i-1] {
+				return nil, 0, ErrInvalidRoaring
+			}
+		}
+		return a, size, nil
+	default:
+		size := 8 * bitmapWords
+		if len(data) < size {
+			return nil, 0, ErrInvalidRoaring
+		}
+		b := &bitmapContainer{}
+		for i := range b.words {
+			b.words[i] = binary.LittleEndian.Uint64(data[8*i:])
+			b.n += bits.OnesCount64(b.words[i])
+		}
+		if b.n != card {
+			return nil, 0, ErrInvalidRoaring
+		}
+		return b, size, nil
+	}
+}
+
+func appendUint16(data []byte, v uint16) []byte {
+	return append(data, byte(v), byte(v>>8))
+}
+
+func appendUint32(data []byte, v uint32) []byte {
+	return appendUint16(appendUint16(data, uint16(v)), uint16(v>>16))
+}
+
+func appendUint64(data []byte, v uint64) []byte {
+	return appendUint32(appendUint32(data, uint32(v)), uint32(v>>32))
+}
+
+// arrayContainer stores up to arrayMaxCard sorted values
+type arrayContainer []uint16
+
+func (a arrayContainer) search(v uint16) (int, bool) {
+	i := sort.Search(len(a), func(i int) bool { return a[i] >= v })
+	return i, i < len(a) && a[i] == v
+}
+
+func (a arrayContainer) add(v uint16) container {
+	i, found := a.search(v)
+	if found {
+		return a
+	}
+	if len(a) >= arrayMaxCard {
+		b := a.toBitmap()
+		b.add(v)
+		return b
+	}
+	a = append(a, 0)
+	copy(a[i+1:], a[i:])
+	a[i] = v
+	return a
+}
+
+func (a arrayContainer) remove(v uint16) container {
+	i, found := a.search(v)
+	if !found {
+		return a
+	}
+	return append(a[:i], a[i+1:]...)
+}
+
+func (a arrayContainer) contains(v uint16) bool {
+	_, found := a.search(v)
+	return found
+}
+
+func (a arrayContainer) card() int {
+	return len(a)
+}
+
+func (a arrayContainer) rank(v uint16) int {
+	i, _ := a.search(v)
+	return i
+}
+
+func (a arrayContainer) selectAt(i int) uint16 {
+	return a[i]
+}
+
+func (a arrayContainer) each(f func(uint16) bool) bool {
+	for _, v := range a {
+		if !f(v) {
+			return false
+		}
+	}
+	return true
+}
+
+func (a arrayContainer) toBitmap() *bitmapContainer {
+	b := &bitmapContainer{n: len(a)}
+	for _, v := range a {
+		b.words[v>>6] |= 1 << (v & 63)
+	}
+	return b
+}
+
+func (a arrayContainer) clone() container {
+	c := make(arrayContainer, len(a))
+	copy(c, a)
+	return c
+}
+
+// bitmapContainer stores values as 65536 bits
+type bitmapContainer struct {
+	words [bitmapWords]uint64
+	n     int
+}
+
+func (b *bitmapContainer) add(v uint16) container {
+	mask := uint64(1) << (v & 63)
+	if b.words[v>>6]&mask == 0 {
+		b.words[v>>6] |= mask
+		b.n++
+	}
+	return b
+}
+
+func (b *bitmapContainer) remove(v uint16) container {
+	mask := uint64(1) << (v & 63)
+	if b.words[v>>6]&mask != 0 {
+		b.words[v>>6] &^= mask
+		b.n--
+	}
+	if b.n <= arrayMaxCard {
+		return b.toArray()
+	}
+	return b
+}
+
+func (b *bitmapContainer) contains(v uint16) bool {
+	return b.words[v>>6]&(1<<(v&63)) != 0
+}
+
+func (b *bitmapContainer) card() int {
+	return b.n
+}
+
+func (b *bitmapContainer) rank(v uint16) int {
+	n := 0
+	for _, w := range b.words[:v>>6] {
+		n += bits.OnesCount64(w)
+	}
+	return n + bits.OnesCount64(b.words[v>>6]&(1<<(v&63)-1))
+}
+
+func (b *bitmapContainer) selectAt(i int) uint16 {
+	for k, w := range b.words {
+		c := bits.OnesCount64(w)
+		if i >= c {
+			i -= c
+			continue
+		}
+		for ; i > 0; i-- {
+			w &= w - 1
+		}
+		return uint16(k<<6 + bits.TrailingZeros64(w))
+	}
+	return 0
+}
+
+func (b *bitmapContainer) each(f func(uint16) bool) bool {
+	for k, w := range b.words {
+		for w != 0 {
+			if !f(uint16(k<<6 + bits.TrailingZeros64(w))) {
+				return false
+			}
+			w &= w - 1
+		}
+	}
+	return true
+}
+
+func (b *bitmapContainer) toBitmap() *bitmapContainer {
+	return b
+}
+
+func (b *bitmapContainer) toArray() arrayContainer {
+	return toArray(b)
+}
+
+func (b *bitmapContainer) clone() container {
+	c := *b
+	return &c
+}
+
+// normalize returns the cheapest container for the bitmap contents, or nil if it is empty
+func (b *bitmapContainer) normalize() container {
+	b.n = 0
+	for _, w := range b.words {
+		b.n += bits.OnesCount64(w)
+	}
+	switch {
+	case b.n == 0:
+		return nil
+	case b.n <= arrayMaxCard:
+		return b.toArray()
+	default:
+		return b
+	}
+}
+
+// run is an inclusive range of values
+type run struct {
+	start, last uint16
+}
+
+// runContainer stores values as sorted, non-overlapping runs
+type runContainer []run
+
+func (rc runContainer) search(v uint16) (int, bool) {
+	i := sort.Search(len(rc), func(i int) bool { return rc[i].last >= v })
+	return i, i < len(rc) && rc[i].start <= v
+}
+
+func (rc runContainer) add(v uint16) container {
+	if rc.contains(v) {
+		return rc
+	}
+	return rc.toBitmap().add(v).toBitmap().normalize()
+}
+
+func (rc runContainer) remove(v uint16) container {
+	if !rc.contains(v) {
+		return rc
+	}
+	b := rc.toBitmap()
+	b.words[v>>6] &^= 1 << (v & 63)
+	if c := b.normalize(); c != nil {
+		return c
+	}
+	return arrayContainer{}
+}
+
+func (rc runContainer) contains(v uint16) bool {
+	_, found := rc.search(v)
+	return found
+}
+
+func (rc runContainer) card() int {
+	n := 0
+	for _, r := range rc {
+		n += int(r.last-r.start) + 1
+	}
+	return n
+}
+
+func (rc runContainer) rank(v uint16) int {
+	n := 0
+	for _, r := range rc {
+		if v <= r.start {
+			break
+		}
+		if v <= r.last {
+			return n + int(v-r.start)
+		}
+		n += int(r.last-r.start) + 1
+	}
+	return n
+}
+
+func (rc runContainer) selectAt(i int) uint16 {
+	for _, r := range rc {
+		size := int(r.last-r.start) + 1
+		if i < size {
+			return r.start + uint16(i)
+		}
+		i -= size
+	}
+	return 0
+}
+
+func (rc runContainer) each(f func(uint16) bool) bool {
+	for _, r := range rc {
+		for v := int(r.start); v <= int(r.last); v++ {
+			if !f(uint16(v)) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func (rc runContainer) toBitmap() *bitmapContainer {
+	b := &bitmapContainer{}
+	for _, r := range rc {
+		for v := int(r.start); v <= int(r.last); v++ {
+			b.words[v>>6] |= 1 << (v & 63)
+		}
+		b.n += int(r.last-r.start) + 1
+	}
+	return b
+}
+
+func (rc runContainer) clone() container {
+	c := make(runContainer, len(rc))
+	copy(c, rc)
+	return c
+}
+
+func toArray(c container) arrayContainer {
+	if a, ok := c.(arrayContainer); ok {
+		return a
+	}
+	a := make(arrayContainer, 0, c.card())
+	c.each(func(v uint16) bool {
+		a = append(a, v)
+		return true
+	})
+	return a
+}
+
+// bitmapCopy returns a bitmap with the container values that is safe to modify
+func bitmapCopy(c container) *bitmapContainer {
+	if b, ok := c.(*bitmapContainer); ok {
+		return b.clone().(*bitmapContainer)
+	}
+	return c.toBitmap()
+}
+
+func toRuns(c container) runContainer {
+	if rc, ok := c.(runContainer); ok {
+		return rc
+	}
+	runs := make(runContainer, 0)
+	c.each(func(v uint16) bool {
+		if n := len(runs); n > 0 && runs[n-1].last+1 == v {
+			runs[n-1].last = v
+			return true
+		}
+		runs = append(runs, run{start: v, last: v})
+		return true
+	})
+	return runs
+}
+
+func containerAnd(a, b container) container {
+	if x, ok := a.(arrayContainer); ok {
+		return filterArray(x, b, true)
+	}
+	if y, ok := b.(arrayContainer); ok {
+		return filterArray(y, a, true)
+	}
+	x, y := bitmapCopy(a), b.toBitmap()
+	for i := range x.words {
+		x.words[i] &= y.words[i]
+	}
+	return x.normalize()
+}
+
+func containerOr(a, b container) container {
+	x, xOk := a.(arrayContainer)
+	y, yOk := b.(arrayContainer)
+	if xOk && yOk && len(x)+len(y) <= arrayMaxCard {
+		result := make(arrayContainer, 0, len(x)+len(y))
+		i, j := 0, 0
+		for i < len(x) && j < len(y) {
+			switch {
+			case x[i] < y[j]:
+				result = append(result, x[i])
+				i++
+			case x[i] > y[j]:
+				result = append(result, y[j])
+				j++
+			default:
+				result = append(result, x[i])
+				i++
+				j++
+			}
+		}
+		result = append(result, x[i:]...)
+		return append(result, y[j:]...)
+	}
+
+	bm, other := bitmapCopy(a), b.toBitmap()
+	for i := range bm.words {
+		bm.words[i] |= other.words[i]
+	}
+	return bm.normalize()
+}
+
+func containerAndNot(a, b container) container {
+	if x, ok := a.(arrayContainer); ok {
+		return filterArray(x, b, false)
+	}
+	x, y := bitmapCopy(a), b.toBitmap()
+	for i := range x.words {
+		x.words[i] &^= y.words[i]
+	}
+	return x.normalize()
+}
+
+func containerXor(a, b container) container {
+	x, y := bitmapCopy(a), b.toBitmap()
+	for i := range x.words {
+		x.words[i] ^= y.words[i]
+	}
+	return x.normalize()
+}
+
+// filterArray returns the values of a that are (keep == true) or are not (keep == false) in b
+func filterArray(a arrayContainer, b container, keep bool) container {
+	result := make(arrayContainer, 0, len(a))
+	for _, v := range a {
+		if b.contains(v) == keep {
+			result = append(result, v)
+		}
+	}
+	if len(result) == 0 {
+		return nil
+	}
+	return result
+}
@@ -0,0 +1,323 @@
+package sets
+
+import (
+	"errors"
+	"math/rand"
+	"reflect"
+	"sort"
+	"testing"
+)
+
+func TestMakeRoaring(t *testing.T) {
+	tests := []struct {
+		name   string
+		values []uint32
+		exp    []uint32
+	}{
+		{name: "empty", exp: []uint32{}},
+		{name: "values", values: []uint32{1 << 20, 5, 70000, 5, 0, 1<<32 - 1}, exp: []uint32{0, 5, 70000, 1 << 20, 1<<32 - 1}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := MakeRoaring(tt.values...)
+			if got := r.Values(); !reflect.DeepEqual(got, tt.exp) {
+				t.Errorf(errorFormat, got, tt.exp)
+			}
+			if got := r.Len(); got != len(tt.exp) {
+				t.Errorf(errorFormat, got, len(tt.exp))
+			}
+		})
+	}
+}
+
+func TestRoaringFromSet(t *testing.T) {
+	s := Make[uint32](3, 1, 70000)
+	r := RoaringFromSet(s)
+	if got := r.ToSet(); !reflect.DeepEqual(got, s) {
+		t.Errorf(errorFormat, got, s)
+	}
+}
+
+// roaringFixture returns sparse, dense and run-shaped values spread over several containers
+func roaringFixture(seed int64) (*Roaring, Set[uint32]) {
+	rnd := rand.New(rand.NewSource(seed))
+	s := Make[uint32]()
+	for i := 0; i < 3000; i++ {
+		s.Add(rnd.Uint32() % (1 << 18))
+	}
+	for i := 0; i < 10000; i++ {
+		s.Add(1<<18 + uint32(rnd.Intn(1<<16)))
+	}
+	for v := uint32(1 << 19); v < 1<<19+20000; v++ {
+		s.Add(v)
+	}
+	r := MakeRoaring()
+	for v := range s {
+		r.Add(v)
+	}
+	return r, s
+}
+
+func sortedValues(s Set[uint32]) []uint32 {
+	values := s.Values()
+	sort.Slice(values, func(i, j int) bool { return values[i] < values[j] })
+	return values
+}
+
+func TestRoaring_Random(t *testing.T) {
+	r, s := roaringFixture(1)
+	if got, exp := r.Values(), sortedValues(s); !reflect.DeepEqual(got, exp) {
+		t.Fatalf(errorFormat, len(got), len(exp))
+	}
+
+	rnd := rand.New(rand.NewSource(2))
+	for i := 0; i < 20000; i++ {
+		v := rnd.Uint32() % (1<<19 + 30000)
+		r.Delete(v)
+		s.Delete(v)
+	}
+	if got, exp := r.Values(), sortedValues(s); !reflect.DeepEqual(got, exp) {
+		t.Fatalf(errorFormat, len(got), len(exp))
+	}
+	for i := 0; i < 1000; i++ {
+		v := rnd.Uint32() % (1<<19 + 30000)
+		if r.Has(v) != s.Has(v) {
+			t.Fatalf(errorFormat, r.Has(v), s.Has(v))
+		}
+	}
+}
+
+func TestRoaring_DenseToSparse(t *testing.T) {
+	r := MakeRoaring()
+	for v := uint32(0); v < 5000; v++ {
+		r.Add(v)
+	}
+	if _, ok := r.containers[0].(*bitmapContainer); !ok {
+		t.Fatalf(errorFormat, reflect.TypeOf(r.containers[0]), "bitmap")
+	}
+	for v := uint32(0); v < 4000; v++ {
+		r.Delete(v)
+	}
+	if _, ok := r.containers[0].(arrayContainer); !ok {
+		t.Fatalf(errorFormat, reflect.TypeOf(r.containers[0]), "array")
+	}
+	if got := r.Len(); got != 1000 {
+		t.Errorf(errorFormat, got, 1000)
+	}
+	r.Delete(r.Values()...)
+	if got := len(r.containers); got != 0 {
+		t.Errorf(errorFormat, got, 0)
+	}
+}
+
+func TestRoaring_RunOptimize(t *testing.T) {
+	r, s := roaringFixture(3)
+	r.RunOptimize()
+
+	runs := 0
+	for _, c := range r.containers {
+		if _, ok := c.(runContainer); ok {
+			runs++
+		}
+	}
+	if runs == 0 {
+		t.Errorf(errorFormat, runs, "at least one run container")
+	}
+	if got, exp := r.Values(), sortedValues(s); !reflect.DeepEqual(got, exp) {
+		t.Fatalf(errorFormat, len(got), len(exp))
+	}
+
+	r.Add(1<<19 + 50000)
+	r.Delete(1<<19 + 10)
+	s.Add(1<<19 + 50000)
+	s.Delete(1<<19 + 10)
+	if got, exp := r.Values(), sortedValues(s); !reflect.DeepEqual(got, exp) {
+		t.Fatalf(errorFormat, len(got), len(exp))
+	}
+}
+
+func TestRoaring_Operations(t *testing.T) {
+	a, sa := roaringFixture(4)
+	b, sb := roaringFixture(5)
+	b.RunOptimize()
+
+	tests := []struct {
+		name string
+		got  *Roaring
+		exp  Set[uint32]
+	}{
+		{name: "and", got: a.And(b), exp: Intersection(sa, sb)},
+		{name: "or", got: a.Or(b), exp: Union(sa, sb)},
+		{name: "and_not", got: a.AndNot(b), exp: Difference(sa, sb)},
+		{name: "xor", got: a.Xor(b), exp: SymmetricDifference(sa, sb)},
+		{name: "and_empty", got: a.And(MakeRoaring()), exp: Make[uint32]()},
+		{name: "or_empty", got: MakeRoaring().Or(a), exp: sa},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got, exp := tt.got.Values(), sortedValues(tt.exp); !reflect.DeepEqual(got, exp) {
+				t.Errorf(errorFormat, len(got), len(exp))
+			}
+		})
+	}
+
+	if got, exp := a.ToSet(), sa; !reflect.DeepEqual(got, exp) {
+		t.Errorf(errorFormat, "modified input", "unchanged input")
+	}
+}
+
+func TestRoaring_RankSelect(t *testing.T) {
+	r, s := roaringFixture(6)
+	r.RunOptimize()
+	values := sortedValues(s)
+
+	for _, k := range []int{0, 1, 100, 2999, 5000, 12000, len(values) - 1} {
+		got, ok := r.Select(k)
+		if !ok || got != values[k] {
+			t.Errorf(errorFormat, got, values[k])
+		}
+		if rank := r.Rank(values[k]); rank != k {
+			t.Errorf(errorFormat, rank, k)
+		}
+	}
+	if got := r.Rank(1<<32 - 1); got != len(values) {
+		t.Errorf(errorFormat, got, len(values))
+	}
+	if _, ok := r.Select(len(values)); ok {
+		t.Errorf(errorFormat, ok, false)
+	}
+	if _, ok := r.Select(-1); ok {
+		t.Errorf(errorFormat, ok, false)
+	}
+}
+
+func TestRoaring_Equals(t *testing.T) {
+	a, _ := roaringFixture(7)
+	b := a.Copy()
+	b.RunOptimize()
+	c := a.Copy()
+	c.Delete(c.Values()[0])
+
+	tests := []struct {
+		name  string
+		r     *Roaring
+		other *Roaring
+		exp   bool
+	}{
+		{name: "empty", r: MakeRoaring(), other: &Roaring{}, exp: true},
+		{name: "different_encodings", r: a, other: b, exp: true},
+		{name: "not_equals", r: a, other: c, exp: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.r.Equals(tt.other); got != tt.exp {
+				t.Errorf(errorFormat, got, tt.exp)
+			}
+		})
+	}
+}
+
+func TestRoaring_Each(t *testing.T) {
+	got := make([]uint32, 0)
+	MakeRoaring(1, 70000, 3).Each(func(v uint32) bool {
+		got = append(got, v)
+		return v < 3
+	})
+	if exp := []uint32{1, 3}; !reflect.DeepEqual(got, exp) {
+		t.Errorf(errorFormat, got, exp)
+	}
+}
+
+func TestRoaring_Binary(t *testing.T) {
+	optimized, _ := roaringFixture(8)
+	optimized.RunOptimize()
+	plain, _ := roaringFixture(9)
+
+	for name, r := range map[string]*Roaring{"empty": MakeRoaring(), "plain": plain, "optimized": optimized} {
+		t.Run(name, func(t *testing.T) {
+			data, err := r.MarshalBinary()
+			if err != nil {
+				t.Fatal(err)
+			}
+			got := MakeRoaring(42)
+			if err = got.UnmarshalBinary(data); err != nil {
+				t.Fatal(err)
+			}
+			if !got.Equals(r) {
+				t.Errorf(errorFormat, got.Len(), r.Len())
+			}
+		})
+	}
+
+	runs := MakeRoaring(1, 2, 3, 1<<16)
+	runs.RunOptimize()
+	formats := []struct {
+		name string
+		r    *Roaring
+		exp  []byte
+	}{
+		{name: "format_empty", r: MakeRoaring(), exp: []byte{0x3a, 0x30, 0, 0, 0, 0, 0, 0}},
+		{
+			name: "format_array",
+			r:    MakeRoaring(1, 2, 3),
+			exp:  []byte{0x3a, 0x30, 0, 0, 1, 0, 0, 0, 0, 0, 2, 0, 16, 0, 0, 0, 1, 0, 2, 0, 3, 0},
+		},
+		{
+			name: "format_runs",
+			r:    runs,
+			exp:  []byte{0x3b, 0x30, 1, 0, 0x01, 0, 0, 2, 0, 1, 0, 0, 0, 1, 0, 1, 0, 2, 0, 0, 0},
+		},
+	}
+	for _, tt := range formats {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := tt.r.MarshalBinary()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(data, tt.exp) {
+				t.Errorf(errorFormat, data, tt.exp)
+			}
+			got := MakeRoaring()
+			if err = got.UnmarshalBinary(tt.exp); err != nil {
+				t.Fatal(err)
+			}
+			if !got.Equals(tt.r) {
+				t.Errorf(errorFormat, got.Values(), tt.r.Values())
+			}
+		})
+	}
+
+	valid, _ := MakeRoaring(1, 2).MarshalBinary()
+	invalid := [][]byte{nil, valid[:4], valid[:len(valid)-1], append(valid, 0)}
+	for _, data := range invalid {
+		if err := new(Roaring).UnmarshalBinary(data); !errors.Is(err, ErrInvalidRoaring) {
+			t.Errorf(errorFormat, err, ErrInvalidRoaring)
+		}
+	}
+}
+
+func BenchmarkRoaring_And(b *testing.B) {
+	x, y := MakeRoaring(), MakeRoaring()
+	for v := uint32(0); v < 1_000_000; v++ {
+		x.Add(v * 3)
+		y.Add(v * 5)
+	}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		x.And(y)
+	}
+}
+
+func BenchmarkSetUint32_Intersection(b *testing.B) {
+	x, y := Make[uint32](), Make[uint32]()
+	for v := uint32(0); v < 1_000_000; v++ {
+		x.Add(v * 3)
+		y.Add(v * 5)
+	}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		Intersection(x, y)
+	}
+}
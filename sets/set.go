@@ -56,26 +56,52 @@ func (s Set[T]) Values() []T {
 	return values
 }
 
+// Each
+// calls the func for each element until the func returns false, without allocating a slice;
+// the func may delete elements from the Set but must not add them
+func (s Set[T]) Each(f func(T) bool) {
+	for v := range s {
+		if !f(v) {
+			return
+		}
+	}
+}
+
 // Merge
 // adds values of the other Sets
 func (s Set[T]) Merge(others ...Set[T]) {
 	for i := range others {
-		s.Add(others[i].Values()...)
+		for v := range others[i] {
+			s[v] = struct{}{}
+		}
 	}
 }
 
 // Diff
-// removes all values represented in any of the other Sets
+// removes all values represented in any of the other Sets; the other Sets are not modified
 func (s Set[T]) Diff(others ...Set[T]) {
-	if len(others) == 0 {
+	switch len(others) {
+	case 0:
+		return
+	case 1:
+		other := others[0]
+		if len(other) < len(s) {
+			for v := range other {
+				delete(s, v)
+			}
+			return
+		}
+		for v := range s {
+			if other.Has(v) {
+				delete(s, v)
+			}
+		}
 		return
 	}
 
-	others[0].Merge(others[1:]...)
-
-	for _, v := range s.Values() {
-		if others[0].Has(v) {
-			s.Delete(v)
+	for v := range s {
+		if hasAny(others, v) {
+			delete(s, v)
 		}
 	}
 }
@@ -88,10 +114,11 @@ func (s *Set[T]) Intersect(others ...Set[T]) {
 		return
 	}
 
-	for _, v := range s.Values() {
+	for v := range *s {
 		for i := range others {
 			if !others[i].Has(v) {
-				s.Delete(v)
+				delete(*s, v)
+				break
 			}
 		}
 	}
@@ -104,7 +131,7 @@ func (s Set[T]) Equals(other Set[T]) bool {
 		return false
 	}
 
-	for _, v := range s.Values() {
+	for v := range s {
 		if !other.Has(v) {
 			return false
 		}
@@ -116,9 +143,9 @@ func (s Set[T]) Equals(other Set[T]) bool {
 // Filter
 // removes elements for which the func returns false
 func (s Set[T]) Filter(f func(T) bool) {
-	for _, v := range s.Values() {
+	for v := range s {
 		if !f(v) {
-			s.Delete(v)
+			delete(s, v)
 		}
 	}
 }
@@ -126,18 +153,152 @@ func (s Set[T]) Filter(f func(T) bool) {
 // Map
 // calls the func for each element
 func (s *Set[T]) Map(f func(T) T) {
-	values := make([]T, 0, s.Len())
-	for _, v := range s.Values() {
-		values = append(values, f(v))
+	newSet := make(Set[T], len(*s))
+	for v := range *s {
+		newSet[f(v)] = struct{}{}
 	}
-	s.Truncate()
-	s.Add(values...)
+	*s = newSet
 }
 
 // Copy
 // returns a copy of the Set
 func (s Set[T]) Copy() Set[T] {
-	newSet := Make[T]()
-	newSet.Add(s.Values()...)
+	newSet := make(Set[T], len(s))
+	for v := range s {
+		newSet[v] = struct{}{}
+	}
 	return newSet
 }
+
+// Relation represents the relationship between two Sets
+type Relation int
+
+const (
+	// Disjoint means the Sets have no common elements
+	Disjoint Relation = iota
+	// Overlapping means the Sets have common elements but neither contains the other
+	Overlapping
+	// Subset means the Set is a proper subset of the other Set
+	Subset
+	// Superset means the Set is a proper superset of the other Set
+	Superset
+	// Equal means the Sets contain the same elements
+	Equal
+)
+
+// String
+// returns the name of the Relation
+func (r Relation) String() string {
+	switch r {
+	case Disjoint:
+		return "disjoint"
+	case Overlapping:
+		return "overlapping"
+	case Subset:
+		return "subset"
+	case Superset:
+		return "superset"
+	case Equal:
+		return "equal"
+	default:
+		return "unknown"
+	}
+}
+
+// IsSubsetOf
+// returns true if every element of the Set is represented in the other Set
+func (s Set[T]) IsSubsetOf(other Set[T]) bool {
+	if len(s) > len(other) {
+		return false
+	}
+
+	for v := range s {
+		if !other.Has(v) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// IsProperSubsetOf
+// returns true if the Set is a subset of the other Set and they are not equal
+func (s Set[T]) IsProperSubsetOf(other Set[T]) bool {
+	return len(s) < len(other) && s.IsSubsetOf(other)
+}
+
+// IsSupersetOf
+// returns true if every element of the other Set is represented in the Set
+func (s Set[T]) IsSupersetOf(other Set[T]) bool {
+	return other.IsSubsetOf(s)
+}
+
+// IsProperSupersetOf
+// returns true if the Set is a superset of the other Set and they are not equal
+func (s Set[T]) IsProperSupersetOf(other Set[T]) bool {
+	return other.IsProperSubsetOf(s)
+}
+
+// IsDisjoint
+// returns true if the Sets have no common elements
+func (s Set[T]) IsDisjoint(other Set[T]) bool {
+	small, large := s, other
+	if len(small) > len(large) {
+		small, large = large, small
+	}
+
+	for v := range small {
+		if large.Has(v) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// HasAll
+// returns true if the Set contains all the values
+func (s Set[T]) HasAll(values ...T) bool {
+	for _, v := range values {
+		if !s.Has(v) {
+			return false
+		}
+	}
+	return true
+}
+
+// HasAny
+// returns true if the Set contains at least one of the values
+func (s Set[T]) HasAny(values ...T) bool {
+	for _, v := range values {
+		if s.Has(v) {
+			return true
+		}
+	}
+	return false
+}
+
+// Compare
+// returns the relationship of the Set to the other Set;
+// two empty Sets are Equal, and an empty Set is a Subset of a non-empty one
+func (s Set[T]) Compare(other Set[T]) Relation {
+	common := 0
+	for v := range s {
+		if other.Has(v) {
+			common++
+		}
+	}
+
+	switch {
+	case common == len(s) && common == len(other):
+		return Equal
+	case common == len(s):
+		return Subset
+	case common == len(other):
+		return Superset
+	case common == 0:
+		return Disjoint
+	default:
+		return Overlapping
+	}
+}
@@ -163,6 +163,42 @@ func TestSet_Values(t *testing.T) {
 	}
 }
 
+func TestSet_Each(t *testing.T) {
+	tests := []struct {
+		name  string
+		set   Set[int]
+		limit int
+		exp   int
+	}{
+		{name: "empty", set: Set[int]{}, limit: 10, exp: 0},
+		{name: "all", set: intSet, limit: 10, exp: 5},
+		{name: "stop", set: intSet, limit: 2, exp: 2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := 0
+			tt.set.Each(func(int) bool {
+				got++
+				return got < tt.limit
+			})
+			if got != tt.exp {
+				t.Errorf(errorFormat, got, tt.exp)
+			}
+		})
+	}
+}
+
+func TestSet_Each_NoAlloc(t *testing.T) {
+	sum := 0
+	f := func(v int) bool {
+		sum += v
+		return true
+	}
+	if got := testing.AllocsPerRun(100, func() { intSet.Each(f) }); got != 0 {
+		t.Errorf(errorFormat, got, 0)
+	}
+}
+
 func TestSet_Merge(t *testing.T) {
 	tests := []struct {
 		name   string
@@ -204,6 +240,48 @@ func TestSet_Diff(t *testing.T) {
 	}
 }
 
+func TestSet_Diff_OthersUnchanged(t *testing.T) {
+	tests := []struct {
+		name   string
+		set    Set[int]
+		others []Set[int]
+		exp    Set[int]
+	}{
+		{name: "single_smaller", set: Make[int](1, 2, 3, 4, 5), others: []Set[int]{Make[int](4, 6)}, exp: Make[int](1, 2, 3, 5)},
+		{name: "single_larger", set: Make[int](1, 2), others: []Set[int]{Make[int](2, 3, 4, 5)}, exp: Make[int](1)},
+		{name: "many", set: Make[int](1, 2, 3, 4, 5), others: []Set[int]{Make[int](4), Make[int](5, 6), Make[int](7)}, exp: Make[int](1, 2, 3)},
+		{name: "nil_other", set: Make[int](1, 2), others: []Set[int]{nil, Make[int](2)}, exp: Make[int](1)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			before := copySets(tt.others)
+			tt.set.Diff(tt.others...)
+			if got := tt.set; !reflect.DeepEqual(got, tt.exp) {
+				t.Errorf(errorFormat, got, tt.exp)
+			}
+			if !reflect.DeepEqual(tt.others, before) {
+				t.Errorf(errorFormat, tt.others, before)
+			}
+		})
+	}
+
+	t.Run("self", func(t *testing.T) {
+		s := Make[int](1, 2, 3)
+		s.Diff(s)
+		if got := s.Len(); got != 0 {
+			t.Errorf(errorFormat, got, 0)
+		}
+	})
+}
+
+func TestSet_Diff_SingleNoAlloc(t *testing.T) {
+	s := Make[int](1, 2, 3, 4, 5)
+	other := Make[int](6, 7)
+	if got := testing.AllocsPerRun(100, func() { s.Diff(other) }); got != 0 {
+		t.Errorf(errorFormat, got, 0)
+	}
+}
+
 func TestSet_Intersect(t *testing.T) {
 	tests := []struct {
 		name   string
@@ -303,3 +381,164 @@ func TestSet_Copy(t *testing.T) {
 		})
 	}
 }
+
+func TestSet_SubsetSuperset(t *testing.T) {
+	tests := []struct {
+		name                                      string
+		set, other                                Set[int]
+		subset, properSubset, superset, properSup bool
+	}{
+		{name: "empty", subset: true, superset: true},
+		{name: "empty_vs_values", other: Make[int](1), subset: true, properSubset: true},
+		{name: "equal", set: Make[int](1, 2), other: Make[int](1, 2), subset: true, superset: true},
+		{name: "subset", set: Make[int](1), other: Make[int](1, 2), subset: true, properSubset: true},
+		{name: "superset", set: Make[int](1, 2, 3), other: Make[int](1, 2), superset: true, properSup: true},
+		{name: "overlapping", set: Make[int](1, 2), other: Make[int](2, 3)},
+		{name: "same_len_differ", set: Make[int](1, 2), other: Make[int](1, 3)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := []bool{tt.set.IsSubsetOf(tt.other), tt.set.IsProperSubsetOf(tt.other), tt.set.IsSupersetOf(tt.other), tt.set.IsProperSupersetOf(tt.other)}
+			exp := []bool{tt.subset, tt.properSubset, tt.superset, tt.properSup}
+			if !reflect.DeepEqual(got, exp) {
+				t.Errorf(errorFormat, got, exp)
+			}
+		})
+	}
+}
+
+func TestSet_IsDisjoint(t *testing.T) {
+	tests := []struct {
+		name       string
+		set, other Set[int]
+		exp        bool
+	}{
+		{name: "empty", exp: true},
+		{name: "disjoint", set: Make[int](1, 2), other: Make[int](3, 4, 5), exp: true},
+		{name: "overlapping", set: Make[int](1, 2, 3), other: Make[int](3), exp: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.set.IsDisjoint(tt.other); got != tt.exp {
+				t.Errorf(errorFormat, got, tt.exp)
+			}
+		})
+	}
+}
+
+func TestSet_HasAllAny(t *testing.T) {
+	tests := []struct {
+		name     string
+		values   []string
+		all, any bool
+	}{
+		{name: "no_values", all: true, any: false},
+		{name: "all", values: []string{"one", "two"}, all: true, any: true},
+		{name: "some", values: []string{"one", "none"}, all: false, any: true},
+		{name: "none", values: []string{"none"}, all: false, any: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := []bool{stringSet.HasAll(tt.values...), stringSet.HasAny(tt.values...)}
+			if exp := []bool{tt.all, tt.any}; !reflect.DeepEqual(got, exp) {
+				t.Errorf(errorFormat, got, exp)
+			}
+		})
+	}
+}
+
+func TestSet_Compare(t *testing.T) {
+	tests := []struct {
+		name       string
+		set, other Set[int]
+		exp        Relation
+	}{
+		{name: "empty", exp: Equal},
+		{name: "equal", set: Make[int](1, 2), other: Make[int](2, 1), exp: Equal},
+		{name: "empty_subset", other: Make[int](1), exp: Subset},
+		{name: "subset", set: Make[int](1), other: Make[int](1, 2), exp: Subset},
+		{name: "superset", set: Make[int](1, 2), other: Make[int](2), exp: Superset},
+		{name: "overlapping", set: Make[int](1, 2), other: Make[int](2, 3), exp: Overlapping},
+		{name: "disjoint", set: Make[int](1, 2), other: Make[int](3, 4), exp: Disjoint},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.set.Compare(tt.other); got != tt.exp {
+				t.Errorf(errorFormat, got, tt.exp)
+			}
+		})
+	}
+}
+
+func benchSet(n int) Set[int] {
+	s := make(Set[int], n)
+	for i := 0; i < n; i++ {
+		s[i] = struct{}{}
+	}
+	return s
+}
+
+func BenchmarkSet_Values(b *testing.B) {
+	s := benchSet(100_000)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		sum := 0
+		for _, v := range s.Values() {
+			sum += v
+		}
+	}
+}
+
+func BenchmarkSet_Each(b *testing.B) {
+	s := benchSet(100_000)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		sum := 0
+		s.Each(func(v int) bool {
+			sum += v
+			return true
+		})
+	}
+}
+
+func BenchmarkSet_Merge(b *testing.B) {
+	other := benchSet(100_000)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		s := benchSet(0)
+		s.Merge(other)
+	}
+}
+
+func BenchmarkSet_Intersect(b *testing.B) {
+	other := benchSet(50_000)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		b.StopTimer()
+		s := benchSet(100_000)
+		b.StartTimer()
+		s.Intersect(other)
+	}
+}
+
+func BenchmarkSet_Equals(b *testing.B) {
+	s, other := benchSet(100_000), benchSet(100_000)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		s.Equals(other)
+	}
+}
+
+func BenchmarkSet_Copy(b *testing.B) {
+	s := benchSet(100_000)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		s.Copy()
+	}
+}
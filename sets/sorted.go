@@ -0,0 +1,507 @@
+package sets
+
+// Ordered
+// represents types that support the < operator
+type Ordered interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 | ~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr |
+		~float32 | ~float64 | ~string
+}
+
+// SortedSet represents a set of elements of type T kept in ascending order.
+// It is backed by a left-leaning red-black tree augmented with subtree sizes,
+// so Add, Delete, Has, Floor, Ceiling, Rank and Select are O(log n).
+// A SortedSet must be created with MakeSorted or MakeSortedFunc:
+// the zero value has no comparator and Add panics on it.
+type SortedSet[T any] struct {
+	root *sortedNode[T]
+	cmp  func(a, b T) int
+}
+
+type sortedNode[T any] struct {
+	value       T
+	left, right *sortedNode[T]
+	size        int
+	red         bool
+}
+
+// MakeSorted
+// creates a new SortedSet of ordered type T
+func MakeSorted[T Ordered](values ...T) *SortedSet[T] {
+	return MakeSortedFunc(compare[T], values...)
+}
+
+// MakeSortedFunc
+// creates a new SortedSet of type T ordered by the comparator, which must return
+// a negative number if a < b, a positive number if a > b and zero if they are equal;
+// panics if the comparator is nil
+func MakeSortedFunc[T any](cmp func(a, b T) int, values ...T) *SortedSet[T] {
+	if cmp == nil {
+		panic("sets: nil comparator in MakeSortedFunc")
+	}
+	s := &SortedSet[T]{cmp: cmp}
+	s.Add(values...)
+	return s
+}
+
+// compare orders NaN below every other value and equal to itself, like cmp.Compare
+func compare[T Ordered](a, b T) int {
+	aNaN, bNaN := a != a, b != b
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	case aNaN && bNaN, a == b:
+		return 0
+	case aNaN:
+		return -1
+	default:
+		return 1
+	}
+}
+
+// Add
+// adds values to the Set; panics if the Set was not created with MakeSorted or MakeSortedFunc
+func (s *SortedSet[T]) Add(values ...T) {
+	if s.cmp == nil && len(values) > 0 {
+		panic("sets: SortedSet must be created with MakeSorted or MakeSortedFunc")
+	}
+	for _, v := range values {
+		s.root = s.put(s.root, v)
+		s.root.red = false
+	}
+}
+
+// Delete
+// deletes values from the Set
+func (s *SortedSet[T]) Delete(values ...T) {
+	for _, v := range values {
+		if !s.Has(v) {
+			continue
+		}
+		if !isRed(s.root.left) && !isRed(s.root.right) {
+			s.root.red = true
+		}
+		s.root = s.delete(s.root, v)
+		if s.root != nil {
+			s.root.red = false
+		}
+	}
+}
+
+// Truncate
+// deletes all values from the Set
+func (s *SortedSet[T]) Truncate() {
+	s.root = nil
+}
+
+// Has
+// returns true if Set contains the value or false if not
+func (s *SortedSet[T]) Has(value T) bool {
+	for n := s.root; n != nil; {
+		c := s.cmp(value, n.value)
+		switch {
+		case c < 0:
+			n = n.left
+		case c > 0:
+			n = n.right
+		default:
+			return true
+		}
+	}
+	return false
+}
+
+// Len
+// returns the length of the Set
+func (s *SortedSet[T]) Len() int {
+	return size(s.root)
+}
+
+// Values
+// returns the Set values in ascending order
+func (s *SortedSet[T]) Values() []T {
+	values := make([]T, 0, s.Len())
+	s.Each(func(v T) bool {
+		values = append(values, v)
+		return true
+	})
+	return values
+}
+
+// Each
+// calls the func for each element in ascending order until the func returns false;
+// the Set must not be modified by the func
+func (s *SortedSet[T]) Each(f func(T) bool) {
+	each(s.root, f)
+}
+
+func each[T any](n *sortedNode[T], f func(T) bool) bool {
+	if n == nil {
+		return true
+	}
+	return each(n.left, f) && f(n.value) && each(n.right, f)
+}
+
+// Merge
+// adds values of the other Sets
+func (s *SortedSet[T]) Merge(others ...*SortedSet[T]) {
+	for i := range others {
+		if others[i] == s {
+			continue
+		}
+		s.Add(others[i].Values()...)
+	}
+}
+
+// Diff
+// removes all values represented in any of the other Sets
+func (s *SortedSet[T]) Diff(others ...*SortedSet[T]) {
+	for i := range others {
+		if others[i] == s {
+			s.Truncate()
+			return
+		}
+	}
+
+	for i := range others {
+		s.Delete(others[i].Values()...)
+	}
+}
+
+// Intersect
+// removes all values not represented in all others Sets
+func (s *SortedSet[T]) Intersect(others ...*SortedSet[T]) {
+	if len(others) == 0 {
+		s.Truncate()
+		return
+	}
+
+	s.Filter(func(v T) bool {
+		for i := range others {
+			if !others[i].Has(v) {
+				return false
+			}
+		}
+		return true
+	})
+}
+
+// Equals
+// returns true if the Sets are equal to each other
+func (s *SortedSet[T]) Equals(other *SortedSet[T]) bool {
+	if s.Len() != other.Len() {
+		return false
+	}
+
+	equal := true
+	s.Each(func(v T) bool {
+		equal = other.Has(v)
+		return equal
+	})
+	return equal
+}
+
+// Filter
+// removes elements for which the func returns false
+func (s *SortedSet[T]) Filter(f func(T) bool) {
+	removed := make([]T, 0)
+	s.Each(func(v T) bool {
+		if !f(v) {
+			removed = append(removed, v)
+		}
+		return true
+	})
+	s.Delete(removed...)
+}
+
+// Map
+// calls the func for each element
+func (s *SortedSet[T]) Map(f func(T) T) {
+	values := s.Values()
+	for i := range values {
+		values[i] = f(values[i])
+	}
+	s.Truncate()
+	s.Add(values...)
+}
+
+// Copy
+// returns a copy of the Set
+func (s *SortedSet[T]) Copy() *SortedSet[T] {
+	return &SortedSet[T]{root: clone(s.root), cmp: s.cmp}
+}
+
+func clone[T any](n *sortedNode[T]) *sortedNode[T] {
+	if n == nil {
+		return nil
+	}
+	c := *n
+	c.left, c.right = clone(n.left), clone(n.right)
+	return &c
+}
+
+// Min
+// returns the smallest element and true, or the zero value and false if the Set is empty
+func (s *SortedSet[T]) Min() (T, bool) {
+	if s.root == nil {
+		return *new(T), false
+	}
+	return minNode(s.root).value, true
+}
+
+// Max
+// returns the largest element and true, or the zero value and false if the Set is empty
+func (s *SortedSet[T]) Max() (T, bool) {
+	if s.root == nil {
+		return *new(T), false
+	}
+	n := s.root
+	for n.right != nil {
+		n = n.right
+	}
+	return n.value, true
+}
+
+// Floor
+// returns the largest element less than or equal to the value, and false if there is none
+func (s *SortedSet[T]) Floor(value T) (T, bool) {
+	var result *sortedNode[T]
+	for n := s.root; n != nil; {
+		c := s.cmp(value, n.value)
+		switch {
+		case c < 0:
+			n = n.left
+		case c > 0:
+			result = n
+			n = n.right
+		default:
+			return n.value, true
+		}
+	}
+	if result == nil {
+		return *new(T), false
+	}
+	return result.value, true
+}
+
+// Ceiling
+// returns the smallest element greater than or equal to the value, and false if there is none
+func (s *SortedSet[T]) Ceiling(value T) (T, bool) {
+	var result *sortedNode[T]
+	for n := s.root; n != nil; {
+		c := s.cmp(value, n.value)
+		switch {
+		case c < 0:
+			result = n
+			n = n.left
+		case c > 0:
+			n = n.right
+		default:
+			return n.value, true
+		}
+	}
+	if result == nil {
+		return *new(T), false
+	}
+	return result.value, true
+}
+
+// Range
+// returns elements from `lo` (include) to `hi` (include) in ascending order
+func (s *SortedSet[T]) Range(lo, hi T) []T {
+	result := make([]T, 0)
+	if s.root == nil || s.cmp(lo, hi) > 0 {
+		return result
+	}
+	s.collectRange(s.root, lo, hi, &result)
+	return result
+}
+
+func (s *SortedSet[T]) collectRange(n *sortedNode[T], lo, hi T, result *[]T) {
+	if n == nil {
+		return
+	}
+	cLo, cHi := s.cmp(lo, n.value), s.cmp(hi, n.value)
+	if cLo < 0 {
+		s.collectRange(n.left, lo, hi, result)
+	}
+	if cLo <= 0 && cHi >= 0 {
+		*result = append(*result, n.value)
+	}
+	if cHi > 0 {
+		s.collectRange(n.right, lo, hi, result)
+	}
+}
+
+// Rank
+// returns the number of elements strictly less than the value
+func (s *SortedSet[T]) Rank(value T) int {
+	rank := 0
+	for n := s.root; n != nil; {
+		c := s.cmp(value, n.value)
+		switch {
+		case c < 0:
+			n = n.left
+		case c > 0:
+			rank += 1 + size(n.left)
+			n = n.right
+		default:
+			return rank + size(n.left)
+		}
+	}
+	return rank
+}
+
+// Select
+// returns the element with the given zero-based rank, and false if k is out of range
+func (s *SortedSet[T]) Select(k int) (T, bool) {
+	if k < 0 || k >= s.Len() {
+		return *new(T), false
+	}
+	n := s.root
+	for {
+		l := size(n.left)
+		switch {
+		case k < l:
+			n = n.left
+		case k > l:
+			k -= l + 1
+			n = n.right
+		default:
+			return n.value, true
+		}
+	}
+}
+
+func isRed[T any](n *sortedNode[T]) bool {
+	return n != nil && n.red
+}
+
+func size[T any](n *sortedNode[T]) int {
+	if n == nil {
+		return 0
+	}
+	return n.size
+}
+
+func minNode[T any](n *sortedNode[T]) *sortedNode[T] {
+	for n.left != nil {
+		n = n.left
+	}
+	return n
+}
+
+func (s *SortedSet[T]) put(h *sortedNode[T], value T) *sortedNode[T] {
+	if h == nil {
+		return &sortedNode[T]{value: value, size: 1, red: true}
+	}
+
+	c := s.cmp(value, h.value)
+	switch {
+	case c < 0:
+		h.left = s.put(h.left, value)
+	case c > 0:
+		h.right = s.put(h.right, value)
+	default:
+		return h
+	}
+
+	return balance(h)
+}
+
+func (s *SortedSet[T]) delete(h *sortedNode[T], value T) *sortedNode[T] {
+	if s.cmp(value, h.value) < 0 {
+		if !isRed(h.left) && !isRed(h.left.left) {
+			h = moveRedLeft(h)
+		}
+		h.left = s.delete(h.left, value)
+	} else {
+		if isRed(h.left) {
+			h = rotateRight(h)
+		}
+		if s.cmp(value, h.value) == 0 && h.right == nil {
+			return nil
+		}
+		if !isRed(h.right) && !isRed(h.right.left) {
+			h = moveRedRight(h)
+		}
+		if s.cmp(value, h.value) == 0 {
+			h.value = minNode(h.right).value
+			h.right = deleteMin(h.right)
+		} else {
+			h.right = s.delete(h.right, value)
+		}
+	}
+	return balance(h)
+}
+
+func deleteMin[T any](h *sortedNode[T]) *sortedNode[T] {
+	if h.left == nil {
+		return nil
+	}
+	if !isRed(h.left) && !isRed(h.left.left) {
+		h = moveRedLeft(h)
+	}
+	h.left = deleteMin(h.left)
+	return balance(h)
+}
+
+func rotateLeft[T any](h *sortedNode[T]) *sortedNode[T] {
+	x := h.right
+	h.right = x.left
+	x.left = h
+	x.red, h.red = h.red, true
+	x.size = h.size
+	h.size = 1 + size(h.left) + size(h.right)
+	return x
+}
+
+func rotateRight[T any](h *sortedNode[T]) *sortedNode[T] {
+	x := h.left
+	h.left = x.right
+	x.right = h
+	x.red, h.red = h.red, true
+	x.size = h.size
+	h.size = 1 + size(h.left) + size(h.right)
+	return x
+}
+
+func flipColors[T any](h *sortedNode[T]) {
+	h.red = !h.red
+	h.left.red = !h.left.red
+	h.right.red = !h.right.red
+}
+
+func moveRedLeft[T any](h *sortedNode[T]) *sortedNode[T] {
+	flipColors(h)
+	if isRed(h.right.left) {
+		h.right = rotateRight(h.right)
+		h = rotateLeft(h)
+		flipColors(h)
+	}
+	return h
+}
+
+func moveRedRight[T any](h *sortedNode[T]) *sortedNode[T] {
+	flipColors(h)
+	if isRed(h.left.left) {
+		h = rotateRight(h)
+		flipColors(h)
+	}
+	return h
+}
+
+func balance[T any](h *sortedNode[T]) *sortedNode[T] {
+	if isRed(h.right) && !isRed(h.left) {
+		h = rotateLeft(h)
+	}
+	if isRed(h.left) && isRed(h.left.left) {
+		h = rotateRight(h)
+	}
+	if isRed(h.left) && isRed(h.right) {
+		flipColors(h)
+	}
+	h.size = 1 + size(h.left) + size(h.right)
+	return h
+}
@@ -0,0 +1,413 @@
+package sets
+
+import (
+	"math"
+	"math/rand"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+)
+
+func TestMakeSorted(t *testing.T) {
+	tests := []struct {
+		name   string
+		values []int
+		exp    []int
+	}{
+		{name: "empty", exp: []int{}},
+		{name: "sorted", values: []int{5, 3, 1, 4, 2, 3, 5}, exp: []int{1, 2, 3, 4, 5}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := MakeSorted[int](tt.values...).Values(); !reflect.DeepEqual(got, tt.exp) {
+				t.Errorf(errorFormat, got, tt.exp)
+			}
+		})
+	}
+}
+
+func TestMakeSortedFunc(t *testing.T) {
+	type user struct {
+		name string
+		age  int
+	}
+	byAge := func(a, b user) int { return a.age - b.age }
+
+	s := MakeSortedFunc(byAge, user{"b", 30}, user{"a", 20}, user{"c", 30})
+	exp := []user{{"a", 20}, {"b", 30}}
+	if got := s.Values(); !reflect.DeepEqual(got, exp) {
+		t.Errorf(errorFormat, got, exp)
+	}
+	if !s.Has(user{age: 20}) {
+		t.Errorf(errorFormat, false, true)
+	}
+
+	desc := MakeSortedFunc(func(a, b string) int { return strings.Compare(b, a) }, "a", "c", "b")
+	if got, exp := desc.Values(), []string{"c", "b", "a"}; !reflect.DeepEqual(got, exp) {
+		t.Errorf(errorFormat, got, exp)
+	}
+}
+
+func TestSortedSet_ZeroValue(t *testing.T) {
+	var s SortedSet[int]
+	if s.Len() != 0 || s.Has(1) || len(s.Values()) != 0 || len(s.Range(0, 10)) != 0 {
+		t.Errorf(errorFormat, s.Values(), []int{})
+	}
+	s.Add()
+
+	tests := []struct {
+		name string
+		f    func()
+		exp  string
+	}{
+		{name: "add", f: func() { s.Add(1) }, exp: "sets: SortedSet must be created with MakeSorted or MakeSortedFunc"},
+		{name: "nil comparator", f: func() { MakeSortedFunc[int](nil) }, exp: "sets: nil comparator in MakeSortedFunc"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer func() {
+				if got := recover(); got != tt.exp {
+					t.Errorf(errorFormat, got, tt.exp)
+				}
+			}()
+			tt.f()
+		})
+	}
+}
+
+func TestSortedSet_Delete(t *testing.T) {
+	tests := []struct {
+		name string
+		del  []int
+		exp  []int
+	}{
+		{name: "nothing", exp: []int{1, 2, 3, 4, 5}},
+		{name: "missing", del: []int{0, 6}, exp: []int{1, 2, 3, 4, 5}},
+		{name: "some", del: []int{1, 3, 5}, exp: []int{2, 4}},
+		{name: "all", del: []int{4, 2, 5, 1, 3}, exp: []int{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := MakeSorted[int](1, 2, 3, 4, 5)
+			s.Delete(tt.del...)
+			if got := s.Values(); !reflect.DeepEqual(got, tt.exp) {
+				t.Errorf(errorFormat, got, tt.exp)
+			}
+		})
+	}
+}
+
+// TestSortedSet_Random compares the tree against a plain Set and checks red-black invariants
+func TestSortedSet_Random(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	s := MakeSorted[int]()
+	ref := Make[int]()
+
+	for i := 0; i < 5000; i++ {
+		v := r.Intn(500)
+		if r.Intn(3) == 0 {
+			s.Delete(v)
+			ref.Delete(v)
+		} else {
+			s.Add(v)
+			ref.Add(v)
+		}
+		if i%250 != 0 {
+			continue
+		}
+		exp := ref.Values()
+		sort.Ints(exp)
+		if got := s.Values(); !reflect.DeepEqual(got, exp) {
+			t.Fatalf(errorFormat, got, exp)
+		}
+		if _, ok := checkLLRB(s.root); !ok {
+			t.Fatalf("red-black invariants violated after %d operations", i)
+		}
+	}
+}
+
+func TestSortedSet_NaN(t *testing.T) {
+	nan := math.NaN()
+
+	s := MakeSorted[float64](1, 2, 3)
+	if s.Has(nan) {
+		t.Errorf(errorFormat, s.Has(nan), false)
+	}
+	if v, ok := s.Floor(nan); ok {
+		t.Errorf(errorFormat, v, nil)
+	}
+	s.Delete(nan)
+	if exp := []float64{1, 2, 3}; !reflect.DeepEqual(s.Values(), exp) {
+		t.Errorf(errorFormat, s.Values(), exp)
+	}
+
+	s.Add(nan, nan)
+	if got := s.Values(); len(got) != 4 || !math.IsNaN(got[0]) || !reflect.DeepEqual(got[1:], []float64{1, 2, 3}) {
+		t.Errorf(errorFormat, got, []float64{nan, 1, 2, 3})
+	}
+	if !s.Has(nan) {
+		t.Errorf(errorFormat, s.Has(nan), true)
+	}
+	if v, ok := s.Floor(nan); !ok || !math.IsNaN(v) {
+		t.Errorf(errorFormat, v, nan)
+	}
+	if v, ok := s.Floor(0); !ok || !math.IsNaN(v) {
+		t.Errorf(errorFormat, v, nan)
+	}
+
+	s.Delete(nan)
+	if exp := []float64{1, 2, 3}; !reflect.DeepEqual(s.Values(), exp) {
+		t.Errorf(errorFormat, s.Values(), exp)
+	}
+}
+
+func checkLLRB[T any](n *sortedNode[T]) (int, bool) {
+	if n == nil {
+		return 1, true
+	}
+	if isRed(n.right) || (isRed(n) && isRed(n.left)) {
+		return 0, false
+	}
+	if n.size != 1+size(n.left)+size(n.right) {
+		return 0, false
+	}
+	l, okL := checkLLRB(n.left)
+	r, okR := checkLLRB(n.right)
+	if !okL || !okR || l != r {
+		return 0, false
+	}
+	if !n.red {
+		l++
+	}
+	return l, true
+}
+
+func TestSortedSet_Truncate(t *testing.T) {
+	s := MakeSorted[int](1, 2, 3)
+	s.Truncate()
+	if got := s.Len(); got != 0 {
+		t.Errorf(errorFormat, got, 0)
+	}
+}
+
+func TestSortedSet_Has(t *testing.T) {
+	s := MakeSorted[string]("one", "two")
+	if !s.Has("one") || s.Has("none") {
+		t.Errorf(errorFormat, []bool{s.Has("one"), s.Has("none")}, []bool{true, false})
+	}
+}
+
+func TestSortedSet_Each(t *testing.T) {
+	got := make([]int, 0)
+	MakeSorted[int](5, 4, 3, 2, 1).Each(func(i int) bool {
+		got = append(got, i)
+		return i < 3
+	})
+	if exp := []int{1, 2, 3}; !reflect.DeepEqual(got, exp) {
+		t.Errorf(errorFormat, got, exp)
+	}
+}
+
+func TestSortedSet_Merge(t *testing.T) {
+	s := MakeSorted[int](1, 2)
+	s.Merge(MakeSorted[int](2, 3, 4), MakeSorted[int](3, 4, 5), s)
+	if got, exp := s.Values(), []int{1, 2, 3, 4, 5}; !reflect.DeepEqual(got, exp) {
+		t.Errorf(errorFormat, got, exp)
+	}
+}
+
+func TestSortedSet_Diff(t *testing.T) {
+	tests := []struct {
+		name   string
+		others []*SortedSet[int]
+		exp    []int
+	}{
+		{name: "empty_others", exp: []int{1, 2, 3, 4, 5}},
+		{name: "diff", others: []*SortedSet[int]{MakeSorted[int](4), MakeSorted[int](5, 9)}, exp: []int{1, 2, 3}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := MakeSorted[int](1, 2, 3, 4, 5)
+			s.Diff(tt.others...)
+			if got := s.Values(); !reflect.DeepEqual(got, tt.exp) {
+				t.Errorf(errorFormat, got, tt.exp)
+			}
+		})
+	}
+}
+
+func TestSortedSet_Intersect(t *testing.T) {
+	tests := []struct {
+		name   string
+		others []*SortedSet[int]
+		exp    []int
+	}{
+		{name: "empty_others", exp: []int{}},
+		{name: "intersect", others: []*SortedSet[int]{MakeSorted[int](1, 2, 3, 4), MakeSorted[int](1, 2, 3, 5)}, exp: []int{1, 2, 3}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := MakeSorted[int](1, 2, 3, 4, 5)
+			s.Intersect(tt.others...)
+			if got := s.Values(); !reflect.DeepEqual(got, tt.exp) {
+				t.Errorf(errorFormat, got, tt.exp)
+			}
+		})
+	}
+}
+
+func TestSortedSet_Equals(t *testing.T) {
+	tests := []struct {
+		name  string
+		set   *SortedSet[int]
+		other *SortedSet[int]
+		exp   bool
+	}{
+		{name: "empty", set: MakeSorted[int](), other: MakeSorted[int](), exp: true},
+		{name: "equals", set: MakeSorted[int](1, 2), other: MakeSorted[int](2, 1), exp: true},
+		{name: "not_equals", set: MakeSorted[int](1, 2), other: MakeSorted[int](1, 3), exp: false},
+		{name: "not_equals_len", set: MakeSorted[int](1, 2), other: MakeSorted[int](1), exp: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.set.Equals(tt.other); got != tt.exp {
+				t.Errorf(errorFormat, got, tt.exp)
+			}
+		})
+	}
+}
+
+func TestSortedSet_Filter(t *testing.T) {
+	s := MakeSorted[int](1, 2, 3, 4, 5)
+	s.Filter(func(i int) bool { return i%2 == 1 })
+	if got, exp := s.Values(), []int{1, 3, 5}; !reflect.DeepEqual(got, exp) {
+		t.Errorf(errorFormat, got, exp)
+	}
+}
+
+func TestSortedSet_Map(t *testing.T) {
+	s := MakeSorted[int](1, 2, 3)
+	s.Map(func(i int) int { return -i })
+	if got, exp := s.Values(), []int{-3, -2, -1}; !reflect.DeepEqual(got, exp) {
+		t.Errorf(errorFormat, got, exp)
+	}
+}
+
+func TestSortedSet_Copy(t *testing.T) {
+	s := MakeSorted[int](2, 1)
+	c := s.Copy()
+	s.Add(3)
+	c.Delete(1)
+	if got, exp := c.Values(), []int{2}; !reflect.DeepEqual(got, exp) {
+		t.Errorf(errorFormat, got, exp)
+	}
+	if got, exp := s.Values(), []int{1, 2, 3}; !reflect.DeepEqual(got, exp) {
+		t.Errorf(errorFormat, got, exp)
+	}
+}
+
+func TestSortedSet_MinMax(t *testing.T) {
+	tests := []struct {
+		name     string
+		set      *SortedSet[int]
+		min, max int
+		ok       bool
+	}{
+		{name: "empty", set: MakeSorted[int]()},
+		{name: "values", set: MakeSorted[int](3, 9, -2, 5), min: -2, max: 9, ok: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			min, ok1 := tt.set.Min()
+			max, ok2 := tt.set.Max()
+			got := []any{min, max, ok1, ok2}
+			exp := []any{tt.min, tt.max, tt.ok, tt.ok}
+			if !reflect.DeepEqual(got, exp) {
+				t.Errorf(errorFormat, got, exp)
+			}
+		})
+	}
+}
+
+func TestSortedSet_FloorCeiling(t *testing.T) {
+	s := MakeSorted[int](10, 20, 30)
+	tests := []struct {
+		name            string
+		val             int
+		floor, ceiling  int
+		okFloor, okCeil bool
+	}{
+		{name: "below", val: 5, ceiling: 10, okCeil: true},
+		{name: "exact", val: 20, floor: 20, ceiling: 20, okFloor: true, okCeil: true},
+		{name: "between", val: 25, floor: 20, ceiling: 30, okFloor: true, okCeil: true},
+		{name: "above", val: 35, floor: 30, okFloor: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			floor, okFloor := s.Floor(tt.val)
+			ceiling, okCeil := s.Ceiling(tt.val)
+			got := []any{floor, okFloor, ceiling, okCeil}
+			exp := []any{tt.floor, tt.okFloor, tt.ceiling, tt.okCeil}
+			if !reflect.DeepEqual(got, exp) {
+				t.Errorf(errorFormat, got, exp)
+			}
+		})
+	}
+}
+
+func TestSortedSet_Range(t *testing.T) {
+	s := MakeSorted[int](1, 3, 5, 7, 9)
+	tests := []struct {
+		name   string
+		lo, hi int
+		exp    []int
+	}{
+		{name: "inverted", lo: 5, hi: 1, exp: []int{}},
+		{name: "all", lo: 0, hi: 10, exp: []int{1, 3, 5, 7, 9}},
+		{name: "inclusive", lo: 3, hi: 7, exp: []int{3, 5, 7}},
+		{name: "between", lo: 4, hi: 6, exp: []int{5}},
+		{name: "none", lo: 10, hi: 20, exp: []int{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := s.Range(tt.lo, tt.hi); !reflect.DeepEqual(got, tt.exp) {
+				t.Errorf(errorFormat, got, tt.exp)
+			}
+		})
+	}
+}
+
+func TestSortedSet_RankSelect(t *testing.T) {
+	s := MakeSorted[int](10, 20, 30, 40)
+	tests := []struct {
+		name string
+		val  int
+		rank int
+	}{
+		{name: "below", val: 5, rank: 0},
+		{name: "first", val: 10, rank: 0},
+		{name: "between", val: 25, rank: 2},
+		{name: "last", val: 40, rank: 3},
+		{name: "above", val: 50, rank: 4},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := s.Rank(tt.val); got != tt.rank {
+				t.Errorf(errorFormat, got, tt.rank)
+			}
+		})
+	}
+
+	for k, exp := range []int{10, 20, 30, 40} {
+		if got, ok := s.Select(k); !ok || got != exp {
+			t.Errorf(errorFormat, got, exp)
+		}
+	}
+	if _, ok := s.Select(4); ok {
+		t.Errorf(errorFormat, ok, false)
+	}
+	if _, ok := s.Select(-1); ok {
+		t.Errorf(errorFormat, ok, false)
+	}
+}
@@ -0,0 +1,94 @@
+package sets
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"fmt"
+
+	"github.com/goiste/generics/internal/pgarray"
+)
+
+// PGArray represents a Set stored as a Postgres array literal (e.g. {"a","b"});
+// convert a Set with PGArray[T](s) to use it as a query argument or scan destination.
+// Elements must be of string, integer, float or bool kind.
+type PGArray[T comparable] Set[T]
+
+// Value
+// implements driver.Valuer, encoding the Set as a JSON array
+func (s Set[T]) Value() (driver.Value, error) {
+	if s == nil {
+		return nil, nil
+	}
+	data, err := s.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return string(data), nil
+}
+
+// Scan
+// implements sql.Scanner, decoding either a JSON array or a Postgres array literal;
+// duplicates are collapsed and NULL results in a nil Set
+func (s *Set[T]) Scan(src any) error {
+	data, err := scanBytes(src)
+	if err != nil {
+		return err
+	}
+	if data == nil {
+		*s = nil
+		return nil
+	}
+
+	data = bytes.TrimSpace(data)
+	if len(data) > 0 && data[0] == '{' {
+		return (*PGArray[T])(s).Scan(src)
+	}
+	return s.UnmarshalJSONTolerant(data)
+}
+
+// Value
+// implements driver.Valuer, encoding the Set as a Postgres array literal with sorted elements
+func (a PGArray[T]) Value() (driver.Value, error) {
+	if a == nil {
+		return nil, nil
+	}
+	values := Set[T](a).Values()
+	if err := sortValues(values); err != nil {
+		return nil, err
+	}
+	return pgarray.Format(values)
+}
+
+// Scan
+// implements sql.Scanner, decoding a Postgres array literal; duplicates are collapsed
+// and NULL results in a nil Set
+func (a *PGArray[T]) Scan(src any) error {
+	data, err := scanBytes(src)
+	if err != nil {
+		return err
+	}
+	if data == nil {
+		*a = nil
+		return nil
+	}
+
+	values, err := pgarray.Parse[T](string(data))
+	if err != nil {
+		return err
+	}
+	*a = PGArray[T](Make[T](values...))
+	return nil
+}
+
+func scanBytes(src any) ([]byte, error) {
+	switch v := src.(type) {
+	case nil:
+		return nil, nil
+	case []byte:
+		return v, nil
+	case string:
+		return []byte(v), nil
+	default:
+		return nil, fmt.Errorf("sets: cannot scan %T into a Set", src)
+	}
+}
@@ -0,0 +1,108 @@
+package sets
+
+import (
+	"database/sql/driver"
+	"reflect"
+	"testing"
+
+	"github.com/goiste/generics/internal/fakedb"
+)
+
+func TestSet_Value(t *testing.T) {
+	tests := []struct {
+		name  string
+		value driver.Valuer
+		exp   driver.Value
+	}{
+		{name: "nil", value: Set[int](nil), exp: nil},
+		{name: "json", value: Make[int](3, 1, 2), exp: `[1,2,3]`},
+		{name: "pg_nil", value: PGArray[int](nil), exp: nil},
+		{name: "pg", value: PGArray[string](Make[string]("b", `a"`)), exp: `{"a\"","b"}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.value.Value()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(got, tt.exp) {
+				t.Errorf(errorFormat, got, tt.exp)
+			}
+		})
+	}
+}
+
+func TestSet_Scan(t *testing.T) {
+	tests := []struct {
+		name    string
+		src     any
+		exp     Set[int]
+		wantErr bool
+	}{
+		{name: "null", src: nil, exp: nil},
+		{name: "json", src: []byte(`[1,2,2,3]`), exp: Make[int](1, 2, 3)},
+		{name: "pg", src: ` {3,2,1,1}`, exp: Make[int](1, 2, 3)},
+		{name: "invalid", src: `[1,`, wantErr: true},
+		{name: "type", src: int64(1), wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Make[int](9)
+			err := got.Scan(tt.src)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf(errorFormat, err, tt.wantErr)
+			}
+			exp := tt.exp
+			if tt.wantErr {
+				exp = Make[int](9)
+			}
+			if !reflect.DeepEqual(got, exp) {
+				t.Errorf(errorFormat, got, exp)
+			}
+		})
+	}
+}
+
+func TestSet_SQLRoundTrip(t *testing.T) {
+	db, err := fakedb.Open()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	tags := Make[string]("go", "sql", "sets")
+
+	t.Run("json", func(t *testing.T) {
+		const key = "INSERT INTO sets_json"
+		if _, err := db.Exec(key, tags); err != nil {
+			t.Fatal(err)
+		}
+		if got, exp := fakedb.Stored(key), `["go","sets","sql"]`; got != exp {
+			t.Errorf(errorFormat, got, exp)
+		}
+		var got Set[string]
+		if err := db.QueryRow("SELECT", key).Scan(&got); err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(got, tags) {
+			t.Errorf(errorFormat, got, tags)
+		}
+	})
+
+	t.Run("pg", func(t *testing.T) {
+		const key = "INSERT INTO sets_pg"
+		if _, err := db.Exec(key, PGArray[string](tags)); err != nil {
+			t.Fatal(err)
+		}
+		if got, exp := fakedb.Stored(key), `{"go","sets","sql"}`; got != exp {
+			t.Errorf(errorFormat, got, exp)
+		}
+		var got Set[string]
+		if err := db.QueryRow("SELECT", key).Scan((*PGArray[string])(&got)); err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(got, tags) {
+			t.Errorf(errorFormat, got, tags)
+		}
+	})
+}
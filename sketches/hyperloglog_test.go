@@ -0,0 +1,116 @@
+package sketches
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"testing"
+)
+
+const errorFormat = "\ngot: %+v\nexp: %+v\n"
+
+func TestNewHyperLogLog(t *testing.T) {
+	tests := []struct {
+		name      string
+		precision int
+		exp       int
+	}{
+		{name: "low", precision: 1, exp: MinPrecision},
+		{name: "high", precision: 30, exp: MaxPrecision},
+		{name: "default", precision: DefaultPrecision, exp: DefaultPrecision},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := NewHyperLogLog[int](tt.precision)
+			if got := h.Precision(); got != tt.exp {
+				t.Errorf(errorFormat, got, tt.exp)
+			}
+			if got := len(h.registers); got != 1<<tt.exp {
+				t.Errorf(errorFormat, got, 1<<tt.exp)
+			}
+		})
+	}
+}
+
+func TestHyperLogLog_Estimate(t *testing.T) {
+	tests := []struct {
+		name      string
+		precision int
+		n         int
+	}{
+		{name: "empty", precision: DefaultPrecision, n: 0},
+		{name: "small", precision: DefaultPrecision, n: 100},
+		{name: "medium", precision: DefaultPrecision, n: 50_000},
+		{name: "large", precision: 12, n: 500_000},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := NewHyperLogLog[string](tt.precision)
+			for i := 0; i < tt.n; i++ {
+				v := fmt.Sprintf("visitor-%d", i)
+				h.Add(v, v)
+			}
+			tolerance := 4 * 1.04 / math.Sqrt(float64(int(1)<<tt.precision)) * float64(tt.n)
+			if got := float64(h.Estimate()); math.Abs(got-float64(tt.n)) > tolerance+1 {
+				t.Errorf(errorFormat, got, tt.n)
+			}
+		})
+	}
+}
+
+func TestHyperLogLog_Merge(t *testing.T) {
+	shards := []*HyperLogLog[int]{NewHyperLogLog[int](12), NewHyperLogLog[int](12), NewHyperLogLog[int](12)}
+	whole := NewHyperLogLog[int](12)
+	for i := 0; i < 30_000; i++ {
+		shards[i%3].Add(i)
+		shards[(i+1)%3].Add(i)
+		whole.Add(i)
+	}
+
+	merged := NewHyperLogLog[int](12)
+	if err := merged.Merge(shards...); err != nil {
+		t.Fatal(err)
+	}
+	if got, exp := merged.Estimate(), whole.Estimate(); got != exp {
+		t.Errorf(errorFormat, got, exp)
+	}
+
+	if err := merged.Merge(NewHyperLogLog[int](10)); !errors.Is(err, ErrIncompatible) {
+		t.Errorf(errorFormat, err, ErrIncompatible)
+	}
+}
+
+func TestHyperLogLog_Binary(t *testing.T) {
+	h := NewHyperLogLog[int](10)
+	for i := 0; i < 5000; i++ {
+		h.Add(i)
+	}
+	data, err := h.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got := NewHyperLogLog[int](4)
+	if err = got.UnmarshalBinary(data); err != nil {
+		t.Fatal(err)
+	}
+	if got.Precision() != 10 || got.Estimate() != h.Estimate() {
+		t.Errorf(errorFormat, got.Estimate(), h.Estimate())
+	}
+
+	bad := append([]byte{}, data...)
+	bad[5] = 100
+	for _, data := range [][]byte{nil, []byte("HLL2" + string(data[4:])), data[:len(data)-1], bad} {
+		if err := got.UnmarshalBinary(data); !errors.Is(err, ErrInvalidData) {
+			t.Errorf(errorFormat, err, ErrInvalidData)
+		}
+	}
+}
+
+func BenchmarkHyperLogLog_Add(b *testing.B) {
+	h := NewHyperLogLog[int](DefaultPrecision)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		h.Add(i)
+	}
+}
@@ -0,0 +1,134 @@
+package slices
+
+import "fmt"
+
+// ErrorMode
+// defines whether the error-returning functions stop at the first error or process all elements
+type ErrorMode int
+
+const (
+	// StopOnError stops at the first error and returns it
+	StopOnError ErrorMode = iota
+	// CollectErrors processes all elements and returns all errors joined together
+	CollectErrors
+)
+
+// IndexError represents an error returned by the func for the element at Index
+type IndexError struct {
+	Index int
+	Err   error
+}
+
+// Error
+// implements the error interface
+func (e *IndexError) Error() string {
+	return fmt.Sprintf("slices: element %d: %v", e.Index, e.Err)
+}
+
+// Unwrap
+// returns the error returned by the func
+func (e *IndexError) Unwrap() error {
+	return e.Err
+}
+
+// MapErr
+// returns a slice filled with the values returned by the func for each slice element.
+// With StopOnError it returns the values of the elements before the failing one and an *IndexError;
+// with CollectErrors the result has the length of the slice, failed elements are left zero
+// and the *IndexErrors of all failed elements are joined into the returned error;
+// returns an empty slice if the func is nil
+func MapErr[T, U any](s []T, f func(value T) (U, error), mode ErrorMode) ([]U, error) {
+	if f == nil {
+		return []U{}, nil
+	}
+
+	result := make([]U, len(s))
+	var errs []error
+
+	for i := range s {
+		v, err := f(s[i])
+		if err != nil {
+			errs = append(errs, &IndexError{Index: i, Err: err})
+			if mode == StopOnError {
+				return result[:i:i], errs[0]
+			}
+			continue
+		}
+		result[i] = v
+	}
+
+	return result, joinErrors(errs)
+}
+
+// FilterErr
+// returns a slice containing elements for which the func returns true.
+// With StopOnError it returns the matching elements before the failing one and an *IndexError;
+// with CollectErrors failed elements are left out and the *IndexErrors of all failed elements
+// are joined into the returned error; returns the slice itself if the func is nil
+func FilterErr[T any](s []T, f func(value T) (bool, error), mode ErrorMode) ([]T, error) {
+	if f == nil {
+		return s, nil
+	}
+
+	result := make([]T, 0, len(s))
+	var errs []error
+
+	for i := range s {
+		keep, err := f(s[i])
+		if err != nil {
+			errs = append(errs, &IndexError{Index: i, Err: err})
+			if mode == StopOnError {
+				break
+			}
+			continue
+		}
+		if keep {
+			result = append(result, s[i])
+		}
+	}
+
+	return result[:len(result):len(result)], joinErrors(errs)
+}
+
+// ReduceErr
+// folds the slice from left to right, starting with the initial accumulator; on error it returns
+// the accumulator of the elements before the failing one and an *IndexError;
+// returns the initial accumulator if the func is nil
+func ReduceErr[T, A any](s []T, initial A, f func(acc A, value T) (A, error)) (A, error) {
+	if f == nil {
+		return initial, nil
+	}
+
+	acc := initial
+	for i := range s {
+		next, err := f(acc, s[i])
+		if err != nil {
+			return acc, &IndexError{Index: i, Err: err}
+		}
+		acc = next
+	}
+	return acc, nil
+}
+
+// TryForEach
+// calls the func for each slice element. With StopOnError it stops at the first error
+// and returns an *IndexError; with CollectErrors the *IndexErrors of all failed elements are joined;
+// does nothing if the func is nil
+func TryForEach[T any](s []T, f func(value T) error, mode ErrorMode) error {
+	if f == nil {
+		return nil
+	}
+
+	var errs []error
+
+	for i := range s {
+		if err := f(s[i]); err != nil {
+			errs = append(errs, &IndexError{Index: i, Err: err})
+			if mode == StopOnError {
+				break
+			}
+		}
+	}
+
+	return joinErrors(errs)
+}
@@ -0,0 +1,32 @@
+//go:build !go1.20
+
+package slices
+
+import (
+	"strconv"
+	"testing"
+)
+
+func TestJoinError(t *testing.T) {
+	err := &joinError{errs: []error{
+		&IndexError{Index: 1, Err: errOdd},
+		&IndexError{Index: 3, Err: strconv.ErrSyntax},
+	}}
+
+	if !err.Is(errOdd) || !err.Is(strconv.ErrSyntax) || err.Is(strconv.ErrRange) {
+		t.Errorf(errorFormat, err, "matches errOdd and ErrSyntax only")
+	}
+
+	var ie *IndexError
+	if !err.As(&ie) || ie.Index != 1 {
+		t.Errorf(errorFormat, ie, 1)
+	}
+	var numErr *strconv.NumError
+	if err.As(&numErr) {
+		t.Errorf(errorFormat, numErr, nil)
+	}
+
+	if exp := "slices: element 1: odd value\nslices: element 3: invalid syntax"; err.Error() != exp {
+		t.Errorf(errorFormat, err.Error(), exp)
+	}
+}
@@ -0,0 +1,184 @@
+package slices
+
+import (
+	"errors"
+	"reflect"
+	"strconv"
+	"testing"
+)
+
+var errOdd = errors.New("odd value")
+
+func failOdd(i int) error {
+	if i%2 == 1 {
+		return errOdd
+	}
+	return nil
+}
+
+// errorIndexes returns the indexes of all *IndexError joined into err
+func errorIndexes(err error) []int {
+	if err == nil {
+		return []int{}
+	}
+	if joined, ok := err.(interface{ Unwrap() []error }); ok {
+		var result []int
+		for _, e := range joined.Unwrap() {
+			result = append(result, errorIndexes(e)...)
+		}
+		return result
+	}
+	var ie *IndexError
+	if errors.As(err, &ie) {
+		return []int{ie.Index}
+	}
+	return nil
+}
+
+func TestMapErr(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   []string
+		f       func(string) (int, error)
+		mode    ErrorMode
+		exp     []int
+		indexes []int
+	}{
+		{name: "nil func", input: []string{"1", "x"}, f: nil, exp: []int{}, indexes: []int{}},
+		{name: "empty", input: []string{}, f: strconv.Atoi, exp: []int{}, indexes: []int{}},
+		{name: "ok", input: []string{"1", "2"}, f: strconv.Atoi, exp: []int{1, 2}, indexes: []int{}},
+		{name: "stop", input: []string{"1", "x", "3", "y"}, f: strconv.Atoi, mode: StopOnError, exp: []int{1}, indexes: []int{1}},
+		{name: "stop first", input: []string{"x", "2"}, f: strconv.Atoi, mode: StopOnError, exp: []int{}, indexes: []int{0}},
+		{name: "collect", input: []string{"1", "x", "3", "y"}, f: strconv.Atoi, mode: CollectErrors, exp: []int{1, 0, 3, 0}, indexes: []int{1, 3}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := MapErr(tt.input, tt.f, tt.mode)
+			if !reflect.DeepEqual(got, tt.exp) {
+				t.Errorf(errorFormat, got, tt.exp)
+			}
+			if indexes := errorIndexes(err); !reflect.DeepEqual(indexes, tt.indexes) {
+				t.Errorf(errorFormat, indexes, tt.indexes)
+			}
+			if len(tt.indexes) > 0 && !errors.Is(err, strconv.ErrSyntax) {
+				t.Errorf(errorFormat, err, strconv.ErrSyntax)
+			}
+		})
+	}
+}
+
+func TestFilterErr(t *testing.T) {
+	f := func(i int) (bool, error) {
+		if i < 0 {
+			return false, errOdd
+		}
+		return i > 2, nil
+	}
+	tests := []struct {
+		name    string
+		input   []int
+		f       func(int) (bool, error)
+		mode    ErrorMode
+		exp     []int
+		indexes []int
+	}{
+		{name: "nil func", input: []int{-1, 3}, f: nil, exp: []int{-1, 3}, indexes: []int{}},
+		{name: "empty", input: []int{}, f: f, exp: []int{}, indexes: []int{}},
+		{name: "ok", input: intSlice, f: f, exp: []int{3, 4, 5}, indexes: []int{}},
+		{name: "stop", input: []int{3, 1, -1, 4}, f: f, mode: StopOnError, exp: []int{3}, indexes: []int{2}},
+		{name: "collect", input: []int{-1, 3, 1, -2, 4}, f: f, mode: CollectErrors, exp: []int{3, 4}, indexes: []int{0, 3}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := FilterErr(tt.input, tt.f, tt.mode)
+			if !reflect.DeepEqual(got, tt.exp) {
+				t.Errorf(errorFormat, got, tt.exp)
+			}
+			if indexes := errorIndexes(err); !reflect.DeepEqual(indexes, tt.indexes) {
+				t.Errorf(errorFormat, indexes, tt.indexes)
+			}
+		})
+	}
+}
+
+func TestReduceErr(t *testing.T) {
+	sum := func(acc, i int) (int, error) {
+		if err := failOdd(i); err != nil && i > 3 {
+			return -1, err
+		}
+		return acc + i, nil
+	}
+	tests := []struct {
+		name    string
+		input   []int
+		f       func(int, int) (int, error)
+		exp     int
+		indexes []int
+	}{
+		{name: "nil func", input: []int{1, 5}, f: nil, exp: 10, indexes: []int{}},
+		{name: "empty", input: []int{}, f: sum, exp: 10, indexes: []int{}},
+		{name: "ok", input: []int{1, 2, 3}, f: sum, exp: 16, indexes: []int{}},
+		{name: "error", input: []int{1, 2, 5, 3}, f: sum, exp: 13, indexes: []int{2}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ReduceErr(tt.input, 10, tt.f)
+			if got != tt.exp {
+				t.Errorf(errorFormat, got, tt.exp)
+			}
+			if indexes := errorIndexes(err); !reflect.DeepEqual(indexes, tt.indexes) {
+				t.Errorf(errorFormat, indexes, tt.indexes)
+			}
+		})
+	}
+}
+
+func TestTryForEach(t *testing.T) {
+	tests := []struct {
+		name    string
+		nilFunc bool
+		mode    ErrorMode
+		calls   int
+		indexes []int
+	}{
+		{name: "nil func", nilFunc: true, calls: 0, indexes: []int{}},
+		{name: "stop", mode: StopOnError, calls: 1, indexes: []int{0}},
+		{name: "collect", mode: CollectErrors, calls: 5, indexes: []int{0, 2, 4}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			calls := 0
+			f := func(i int) error {
+				calls++
+				return failOdd(i)
+			}
+			if tt.nilFunc {
+				f = nil
+			}
+			err := TryForEach(intSlice, f, tt.mode)
+			if calls != tt.calls {
+				t.Errorf(errorFormat, calls, tt.calls)
+			}
+			if indexes := errorIndexes(err); !reflect.DeepEqual(indexes, tt.indexes) {
+				t.Errorf(errorFormat, indexes, tt.indexes)
+			}
+			if len(tt.indexes) > 0 && !errors.Is(err, errOdd) {
+				t.Errorf(errorFormat, err, errOdd)
+			}
+		})
+	}
+
+	if err := TryForEach([]int{2, 4}, failOdd, CollectErrors); err != nil {
+		t.Errorf(errorFormat, err, nil)
+	}
+}
+
+func TestIndexError(t *testing.T) {
+	err := error(&IndexError{Index: 3, Err: errOdd})
+	if exp := "slices: element 3: odd value"; err.Error() != exp {
+		t.Errorf(errorFormat, err.Error(), exp)
+	}
+	if !errors.Is(err, errOdd) {
+		t.Errorf(errorFormat, err, errOdd)
+	}
+}
@@ -0,0 +1,12 @@
+//go:build go1.20
+
+package slices
+
+import "errors"
+
+func joinErrors(errs []error) error {
+	if len(errs) == 1 {
+		return errs[0]
+	}
+	return errors.Join(errs...)
+}
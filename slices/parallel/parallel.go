@@ -0,0 +1,291 @@
+// Package parallel provides slice operations that spread the work over a pool of goroutines.
+//
+// All operations keep the order of the input and run on the calling goroutine for small inputs.
+// They stop when the context is cancelled or the func returns an error; the returned error is
+// the one of the failing element with the lowest index, as in a sequential loop.
+// A panic in the func is not recovered: when it happens on a worker goroutine it crashes
+// the program, as a panic in any other goroutine would.
+package parallel
+
+import (
+	"context"
+	"runtime"
+	"sync"
+	"sync/atomic"
+)
+
+// DefaultMinParallel is the input length below which operations run sequentially by default
+const DefaultMinParallel = 1024
+
+// chunksPerWorker splits the input into more chunks than workers to even out uneven work
+const chunksPerWorker = 4
+
+// Option configures a parallel operation
+type Option func(*config)
+
+type config struct {
+	workers     int
+	minParallel int
+}
+
+// WithWorkers
+// caps the number of goroutines; non-positive `n` means runtime.GOMAXPROCS(0)
+func WithWorkers(n int) Option {
+	return func(c *config) {
+		c.workers = n
+	}
+}
+
+// WithMinParallel
+// sets the input length below which the operation runs sequentially on the calling goroutine;
+// use 0 to always run in parallel, e.g. when each call of the func is slow
+func WithMinParallel(n int) Option {
+	return func(c *config) {
+		c.minParallel = n
+	}
+}
+
+// ParallelMap
+// returns a slice filled with the values returned by the func for each slice element;
+// on error the result is nil; returns an empty slice if the func is nil
+func ParallelMap[T, U any](ctx context.Context, s []T, f func(ctx context.Context, value T) (U, error), opts ...Option) ([]U, error) {
+	if f == nil {
+		return []U{}, nil
+	}
+
+	result := make([]U, len(s))
+	err := newConfig(opts).run(ctx, len(s), func(ctx context.Context, _, lo, hi int) error {
+		done := ctx.Done()
+		for i := lo; i < hi; i++ {
+			select {
+			case <-done:
+				return ctx.Err()
+			default:
+			}
+			v, err := f(ctx, s[i])
+			if err != nil {
+				return err
+			}
+			result[i] = v
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// ParallelFilter
+// returns a slice containing elements for which the func returns true;
+// on error the result is nil; returns the slice itself if the func is nil
+func ParallelFilter[T any](ctx context.Context, s []T, f func(ctx context.Context, value T) (bool, error), opts ...Option) ([]T, error) {
+	if f == nil {
+		return s, nil
+	}
+
+	keep, err := ParallelMap(ctx, s, f, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	n := 0
+	for _, k := range keep {
+		if k {
+			n++
+		}
+	}
+	result := make([]T, 0, n)
+	for i, k := range keep {
+		if k {
+			result = append(result, s[i])
+		}
+	}
+	return result, nil
+}
+
+// ParallelReduce
+// folds consecutive chunks of the slice in parallel and joins the partial results in order
+// with the combiner, which must be associative. Every chunk starts from `initial`,
+// so it must be an identity value for the combiner (e.g. 0 for a sum).
+// Returns the initial accumulator if the func or the combiner is nil
+func ParallelReduce[T, A any](
+	ctx context.Context,
+	s []T,
+	initial A,
+	f func(ctx context.Context, acc A, value T) (A, error),
+	combine func(a, b A) A,
+	opts ...Option,
+) (A, error) {
+	if f == nil || combine == nil {
+		return initial, nil
+	}
+
+	c := newConfig(opts)
+	_, count := c.chunks(len(s))
+	partials := make([]A, count)
+
+	err := c.run(ctx, len(s), func(ctx context.Context, chunk, lo, hi int) error {
+		done := ctx.Done()
+		acc := initial
+		for i := lo; i < hi; i++ {
+			select {
+			case <-done:
+				return ctx.Err()
+			default:
+			}
+			var err error
+			if acc, err = f(ctx, acc, s[i]); err != nil {
+				return err
+			}
+		}
+		partials[chunk] = acc
+		return nil
+	})
+	if err != nil {
+		var zero A
+		return zero, err
+	}
+
+	result := initial
+	for i := range partials {
+		result = combine(result, partials[i])
+	}
+	return result, nil
+}
+
+// ParallelForEach
+// calls the func for each slice element; does nothing if the func is nil
+func ParallelForEach[T any](ctx context.Context, s []T, f func(ctx context.Context, value T) error, opts ...Option) error {
+	if f == nil {
+		return nil
+	}
+
+	return newConfig(opts).run(ctx, len(s), func(ctx context.Context, _, lo, hi int) error {
+		done := ctx.Done()
+		for i := lo; i < hi; i++ {
+			select {
+			case <-done:
+				return ctx.Err()
+			default:
+			}
+			if err := f(ctx, s[i]); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func newConfig(opts []Option) config {
+	c := config{minParallel: DefaultMinParallel}
+	for _, opt := range opts {
+		opt(&c)
+	}
+	if c.workers <= 0 {
+		c.workers = runtime.GOMAXPROCS(0)
+	}
+	return c
+}
+
+// chunks returns the size and the number of chunks the input of length `n` is split into
+func (c config) chunks(n int) (size, count int) {
+	if n == 0 {
+		return 0, 0
+	}
+	if c.workers == 1 || n < c.minParallel {
+		return n, 1
+	}
+	parts := c.workers * chunksPerWorker
+	size = (n + parts - 1) / parts
+	return size, (n + size - 1) / size
+}
+
+// run calls body for every chunk of the input of length `n`, using up to c.workers goroutines,
+// and returns the error of the failing chunk with the lowest index; after an error the chunks
+// following the failing one are cancelled or skipped
+func (c config) run(parent context.Context, n int, body func(ctx context.Context, chunk, lo, hi int) error) error {
+	size, count := c.chunks(n)
+	if count <= 1 {
+		if err := parent.Err(); err != nil {
+			return err
+		}
+		if count == 0 {
+			return nil
+		}
+		return body(parent, 0, 0, n)
+	}
+
+	var (
+		wg       sync.WaitGroup
+		mu       sync.Mutex
+		cancels  = make([]context.CancelFunc, count)
+		errChunk = count
+		firstErr error
+		next     int64 = -1
+		finished int64
+	)
+
+	workers := c.workers
+	if workers > count {
+		workers = count
+	}
+	wg.Add(workers)
+	for w := 0; w < workers; w++ {
+		go func() {
+			defer wg.Done()
+			for {
+				// chunks are taken in ascending order, so once one is skipped all the following are too
+				chunk := int(atomic.AddInt64(&next, 1))
+				if chunk >= count || parent.Err() != nil {
+					return
+				}
+				mu.Lock()
+				if chunk > errChunk {
+					mu.Unlock()
+					return
+				}
+				ctx, cancel := context.WithCancel(parent)
+				cancels[chunk] = cancel
+				mu.Unlock()
+
+				lo := chunk * size
+				hi := lo + size
+				if hi > n {
+					hi = n
+				}
+				err := body(ctx, chunk, lo, hi)
+
+				mu.Lock()
+				cancels[chunk] = nil
+				if err != nil && chunk < errChunk {
+					// only chunks after the failing one are cancelled, so that an error
+					// in an earlier chunk still takes precedence
+					errChunk, firstErr = chunk, err
+					for i := chunk + 1; i < count; i++ {
+						if cancels[i] != nil {
+							cancels[i]()
+						}
+					}
+				}
+				mu.Unlock()
+				cancel()
+
+				if err != nil {
+					return
+				}
+				atomic.AddInt64(&finished, 1)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if firstErr != nil {
+		return firstErr
+	}
+	if int(finished) < count {
+		// workers only stop early when the parent context is cancelled
+		return parent.Err()
+	}
+	return nil
+}
@@ -0,0 +1,285 @@
+package parallel
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/goiste/generics/slices"
+)
+
+const errorFormat = "\ngot: %+v\nexp: %+v\n"
+
+var errTest = errors.New("test error")
+
+func double(_ context.Context, v int) (int, error) {
+	return v * 2, nil
+}
+
+func failAt(n int) func(context.Context, int) (int, error) {
+	return func(_ context.Context, v int) (int, error) {
+		if v == n {
+			return 0, errTest
+		}
+		return v, nil
+	}
+}
+
+func TestParallelMap(t *testing.T) {
+	large := slices.Range(0, 10_000, 1)
+	tests := []struct {
+		name  string
+		input []int
+		f     func(context.Context, int) (int, error)
+		opts  []Option
+		exp   []int
+		err   error
+	}{
+		{name: "empty", input: []int{}, f: double, exp: []int{}},
+		{name: "small", input: []int{1, 2, 3}, f: double, exp: []int{2, 4, 6}},
+		{name: "large", input: large, f: double, exp: slices.Map(large, func(v int) int { return v * 2 })},
+		{name: "forced parallel", input: []int{1, 2, 3}, f: double, opts: []Option{WithMinParallel(0), WithWorkers(3)}, exp: []int{2, 4, 6}},
+		{name: "one worker", input: large, f: double, opts: []Option{WithWorkers(1)}, exp: slices.Map(large, func(v int) int { return v * 2 })},
+		{name: "error small", input: []int{1, 2, 3}, f: failAt(2), err: errTest},
+		{name: "error large", input: large, f: failAt(5_000), err: errTest},
+		{name: "nil func", input: large, f: nil, exp: []int{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParallelMap(context.Background(), tt.input, tt.f, tt.opts...)
+			if !errors.Is(err, tt.err) {
+				t.Fatalf(errorFormat, err, tt.err)
+			}
+			if !reflect.DeepEqual(got, tt.exp) {
+				t.Errorf(errorFormat, got, tt.exp)
+			}
+		})
+	}
+}
+
+func TestParallelFilter(t *testing.T) {
+	large := slices.Range(0, 10_000, 1)
+	even := func(_ context.Context, v int) (bool, error) { return v%2 == 0, nil }
+	tests := []struct {
+		name  string
+		input []int
+		f     func(context.Context, int) (bool, error)
+		exp   []int
+		err   error
+	}{
+		{name: "empty", input: []int{}, f: even, exp: []int{}},
+		{name: "small", input: []int{1, 2, 3, 4}, f: even, exp: []int{2, 4}},
+		{name: "large", input: large, f: even, exp: slices.Range(0, 10_000, 2)},
+		{name: "error", input: large, f: func(_ context.Context, v int) (bool, error) {
+			return false, errTest
+		}, err: errTest},
+		{name: "nil func", input: large, f: nil, exp: large},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParallelFilter(context.Background(), tt.input, tt.f)
+			if !errors.Is(err, tt.err) {
+				t.Fatalf(errorFormat, err, tt.err)
+			}
+			if !reflect.DeepEqual(got, tt.exp) {
+				t.Errorf(errorFormat, got, tt.exp)
+			}
+		})
+	}
+}
+
+func TestParallelReduce(t *testing.T) {
+	words := slices.MapTo(slices.Range(0, 5_000, 1), func(v int) string { return fmt.Sprint(v % 10) })
+	concat := func(_ context.Context, acc string, v string) (string, error) { return acc + v, nil }
+	join := func(a, b string) string { return a + b }
+
+	tests := []struct {
+		name  string
+		input []string
+		opts  []Option
+	}{
+		{name: "empty", input: []string{}},
+		{name: "sequential", input: words[:10]},
+		{name: "parallel", input: words, opts: []Option{WithMinParallel(0), WithWorkers(7)}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParallelReduce(context.Background(), tt.input, "", concat, join, tt.opts...)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if exp := slices.Reduce(tt.input, "", func(acc, v string) string { return acc + v }); got != exp {
+				t.Errorf(errorFormat, len(got), len(exp))
+			}
+		})
+	}
+
+	_, err := ParallelReduce(context.Background(), words, "", func(_ context.Context, acc string, v string) (string, error) {
+		return "", errTest
+	}, join, WithMinParallel(0))
+	if !errors.Is(err, errTest) {
+		t.Errorf(errorFormat, err, errTest)
+	}
+
+	nilTests := []struct {
+		name    string
+		f       func(context.Context, string, string) (string, error)
+		combine func(a, b string) string
+	}{
+		{name: "nil func", combine: join},
+		{name: "nil combiner", f: concat},
+	}
+	for _, tt := range nilTests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParallelReduce(context.Background(), words, "init", tt.f, tt.combine, WithMinParallel(0))
+			if err != nil || got != "init" {
+				t.Errorf(errorFormat, got, "init")
+			}
+		})
+	}
+}
+
+func TestParallelForEach(t *testing.T) {
+	var sum int64
+	err := ParallelForEach(context.Background(), slices.Range(1, 10_001, 1), func(_ context.Context, v int) error {
+		atomic.AddInt64(&sum, int64(v))
+		return nil
+	})
+	if err != nil || sum != 50_005_000 {
+		t.Errorf(errorFormat, sum, 50_005_000)
+	}
+	if err = ParallelForEach[int](context.Background(), []int{1, 2}, nil); err != nil {
+		t.Errorf(errorFormat, err, nil)
+	}
+}
+
+func TestWorkers(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   []int
+		opts    []Option
+		maxExp  int64
+		minUsed int64
+	}{
+		{name: "capped", input: slices.Range(0, 200, 1), opts: []Option{WithWorkers(3), WithMinParallel(0)}, maxExp: 3, minUsed: 2},
+		{name: "sequential fallback", input: slices.Range(0, 50, 1), opts: []Option{WithWorkers(8), WithMinParallel(100)}, maxExp: 1, minUsed: 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var running, max int64
+			err := ParallelForEach(context.Background(), tt.input, func(_ context.Context, _ int) error {
+				n := atomic.AddInt64(&running, 1)
+				defer atomic.AddInt64(&running, -1)
+				for {
+					m := atomic.LoadInt64(&max)
+					if n <= m || atomic.CompareAndSwapInt64(&max, m, n) {
+						break
+					}
+				}
+				time.Sleep(100 * time.Microsecond)
+				return nil
+			}, tt.opts...)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if max > tt.maxExp || max < tt.minUsed {
+				t.Errorf(errorFormat, max, tt.maxExp)
+			}
+		})
+	}
+}
+
+func TestCancellation(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := ParallelMap(ctx, []int{1, 2, 3}, double); !errors.Is(err, context.Canceled) {
+		t.Errorf(errorFormat, err, context.Canceled)
+	}
+
+	ctx, cancel = context.WithCancel(context.Background())
+	defer cancel()
+	var calls int64
+	input := slices.Range(0, 100_000, 1)
+	err := ParallelForEach(ctx, input, func(ctx context.Context, v int) error {
+		if atomic.AddInt64(&calls, 1) == 100 {
+			cancel()
+		}
+		return nil
+	}, WithWorkers(4))
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf(errorFormat, err, context.Canceled)
+	}
+	if calls >= int64(len(input)) {
+		t.Errorf(errorFormat, calls, "fewer calls than elements")
+	}
+}
+
+func TestFirstErrorStopsWork(t *testing.T) {
+	var calls int64
+	input := slices.Range(0, 100_000, 1)
+	err := ParallelForEach(context.Background(), input, func(ctx context.Context, v int) error {
+		atomic.AddInt64(&calls, 1)
+		if v == 10 {
+			return errTest
+		}
+		return nil
+	}, WithWorkers(4))
+	if !errors.Is(err, errTest) {
+		t.Errorf(errorFormat, err, errTest)
+	}
+	if calls >= int64(len(input)) {
+		t.Errorf(errorFormat, calls, "fewer calls than elements")
+	}
+}
+
+func TestLowestIndexError(t *testing.T) {
+	input := slices.Range(0, 400, 1)
+	failing := map[int]bool{150: true, 210: true, 390: true}
+	f := func(_ context.Context, v int) (int, error) {
+		if v < 200 {
+			// earlier elements are slower, so later chunks fail first in time
+			time.Sleep(20 * time.Microsecond)
+		}
+		if failing[v] {
+			return 0, fmt.Errorf("element %d", v)
+		}
+		return v, nil
+	}
+
+	_, seqErr := ParallelMap(context.Background(), input, f, WithWorkers(1))
+	for i := 0; i < 20; i++ {
+		_, err := ParallelMap(context.Background(), input, f, WithWorkers(8), WithMinParallel(0))
+		if err == nil || err.Error() != seqErr.Error() {
+			t.Fatalf(errorFormat, err, seqErr)
+		}
+	}
+	if exp := "element 150"; seqErr.Error() != exp {
+		t.Errorf(errorFormat, seqErr, exp)
+	}
+}
+
+func BenchmarkParallelMap(b *testing.B) {
+	s := slices.Range(0, 100_000, 1)
+	work := func(_ context.Context, v int) (int, error) {
+		for i := 0; i < 100; i++ {
+			v = v*31 + i
+		}
+		return v, nil
+	}
+	b.Run("sequential", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_, _ = ParallelMap(context.Background(), s, work, WithWorkers(1))
+		}
+	})
+	b.Run("parallel", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_, _ = ParallelMap(context.Background(), s, work)
+		}
+	})
+}
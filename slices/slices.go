@@ -3,7 +3,6 @@ package slices
 import (
 	"fmt"
 	"math"
-	"sort"
 )
 
 // Number
@@ -66,21 +65,20 @@ func Diff[T comparable](s []T, others ...[]T) []T {
 		return s
 	}
 
-	valuesMap := make(map[T]struct{}, len(s))
-	for i := range s {
-		valuesMap[s[i]] = struct{}{}
-	}
-
-	result := Copy(s)
+	excluded := map[T]struct{}{}
 	for i := range others {
 		for j := range others[i] {
-			if _, exists := valuesMap[others[i][j]]; exists {
-				result = RemoveValue(result, others[i][j])
-			}
+			excluded[others[i][j]] = struct{}{}
 		}
 	}
 
-	return result
+	result := make([]T, 0, len(s))
+	for i := range s {
+		if _, exists := excluded[s[i]]; !exists {
+			result = append(result, s[i])
+		}
+	}
+	return result[:len(result):len(result)]
 }
 
 // Intersect
@@ -90,23 +88,31 @@ func Intersect[T comparable](s []T, others ...[]T) []T {
 		return []T{}
 	}
 
-	sort.Slice(others, func(i, j int) bool {
-		return len(others[i]) < len(others[j])
-	})
-
-	if len(others[0]) == 0 {
-		return []T{}
+	candidates := make(map[T]struct{}, len(s))
+	for i := range s {
+		candidates[s[i]] = struct{}{}
 	}
 
-	result := Copy(s)
 	for i := range others {
-		result = Filter(result, func(t T) bool { return HasValue(others[i], t) })
-		if len(result) == 0 {
-			return result
+		found := make(map[T]struct{}, len(candidates))
+		for j := range others[i] {
+			if _, exists := candidates[others[i][j]]; exists {
+				found[others[i][j]] = struct{}{}
+			}
 		}
+		if len(found) == 0 {
+			return []T{}
+		}
+		candidates = found
 	}
 
-	return result
+	result := make([]T, 0, len(s))
+	for i := range s {
+		if _, exists := candidates[s[i]]; exists {
+			result = append(result, s[i])
+		}
+	}
+	return result[:len(result):len(result)]
 }
 
 // SafeSlice
@@ -145,8 +151,21 @@ func Split[T any](s []T, partSize int) [][]T {
 // returns a slice containing all unique elements of all slices
 func Merge[T comparable](s []T, others ...[]T) []T {
 	result := Copy(s)
+	seen := make(map[T]struct{}, len(s))
+	for i := range s {
+		seen[s[i]] = struct{}{}
+	}
+
 	for i := range others {
-		result = append(result, Diff(others[i], result)...)
+		added := len(result)
+		for j := range others[i] {
+			if _, exists := seen[others[i][j]]; !exists {
+				result = append(result, others[i][j])
+			}
+		}
+		for _, v := range result[added:] {
+			seen[v] = struct{}{}
+		}
 	}
 	return result
 }
@@ -188,17 +207,37 @@ func Filter[T any](s []T, f func(value T) bool) []T {
 		return s
 	}
 
-	result := Copy(s)
+	result := make([]T, 0, len(s))
+	for i := range s {
+		if f(s[i]) {
+			result = append(result, s[i])
+		}
+	}
+	return result[:len(result):len(result)]
+}
 
-	for i := 0; i < len(result); {
-		if !f(result[i]) {
-			result = RemoveIdx(result, i)
-			continue
+// FilterInPlace
+// keeps the elements for which the func returns true, reusing the backing array of the slice;
+// the original slice must not be used afterwards
+func FilterInPlace[T any](s []T, f func(value T) bool) []T {
+	if f == nil {
+		return s
+	}
+
+	n := 0
+	for i := range s {
+		if f(s[i]) {
+			s[n] = s[i]
+			n++
 		}
-		i++
 	}
 
-	return result
+	var zero T
+	for i := n; i < len(s); i++ {
+		s[i] = zero
+	}
+
+	return s[:n]
 }
 
 // Map
@@ -217,6 +256,91 @@ func Map[T any](s []T, f func(value T) T) []T {
 	return result
 }
 
+// MapTo
+// returns a slice filled with the values of type U returned by the func for each slice element;
+// returns an empty slice if the func is nil
+func MapTo[T, U any](s []T, f func(value T) U) []U {
+	if f == nil {
+		return []U{}
+	}
+
+	result := make([]U, len(s))
+
+	for i := range s {
+		result[i] = f(s[i])
+	}
+
+	return result
+}
+
+// FlatMap
+// returns a slice containing the concatenated slices returned by the func for each slice element;
+// returns an empty slice if the func is nil
+func FlatMap[T, U any](s []T, f func(value T) []U) []U {
+	if f == nil {
+		return []U{}
+	}
+
+	result := make([]U, 0, len(s))
+
+	for i := range s {
+		result = append(result, f(s[i])...)
+	}
+
+	return result
+}
+
+// Reduce
+// folds the slice from left to right, starting with the initial accumulator;
+// returns the initial accumulator if the func is nil
+func Reduce[T, A any](s []T, initial A, f func(acc A, value T) A) A {
+	if f == nil {
+		return initial
+	}
+
+	acc := initial
+	for i := range s {
+		acc = f(acc, s[i])
+	}
+
+	return acc
+}
+
+// ReduceRight
+// folds the slice from right to left, starting with the initial accumulator;
+// returns the initial accumulator if the func is nil
+func ReduceRight[T, A any](s []T, initial A, f func(acc A, value T) A) A {
+	if f == nil {
+		return initial
+	}
+
+	acc := initial
+	for i := len(s) - 1; i >= 0; i-- {
+		acc = f(acc, s[i])
+	}
+
+	return acc
+}
+
+// Scan
+// returns the running accumulations of Reduce: the i-th element is the accumulator after folding s[:i+1];
+// returns an empty slice if the func is nil
+func Scan[T, A any](s []T, initial A, f func(acc A, value T) A) []A {
+	if f == nil {
+		return []A{}
+	}
+
+	result := make([]A, len(s))
+
+	acc := initial
+	for i := range s {
+		acc = f(acc, s[i])
+		result[i] = acc
+	}
+
+	return result
+}
+
 // Convert
 // converts all elements from numeric type T to numeric type V
 func Convert[T, V Number](s []T) []V {
@@ -2,7 +2,9 @@ package slices
 
 import (
 	"fmt"
+	"math/rand"
 	"reflect"
+	"sort"
 	"testing"
 )
 
@@ -160,6 +162,7 @@ func TestIntersect(t *testing.T) {
 		{name: "one_empty_other", input: stringSlice, others: [][]string{{"nine", "one"}, {}}, exp: []string{}},
 		{name: "no_intersect", input: stringSlice, others: [][]string{{"nine", "ten"}}, exp: []string{}},
 		{name: "one", input: stringSlice, others: [][]string{{"one", "none"}, {"nine", "one"}}, exp: []string{"one"}},
+		{name: "order_of_s", input: stringSlice, others: [][]string{{"four", "two", "one", "two"}, {"one", "two", "four"}}, exp: []string{"one", "two", "four"}},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -170,6 +173,89 @@ func TestIntersect(t *testing.T) {
 	}
 }
 
+func TestIntersect_KeepsOthersOrder(t *testing.T) {
+	others := [][]int{{1, 2, 3, 4}, {3}, {3, 4}}
+	Intersect([]int{3, 4}, others...)
+	if exp := [][]int{{1, 2, 3, 4}, {3}, {3, 4}}; !reflect.DeepEqual(others, exp) {
+		t.Errorf(errorFormat, others, exp)
+	}
+}
+
+// diffQuadratic, intersectQuadratic and mergeQuadratic are the former implementations,
+// kept as a reference for the semantics and as benchmark baselines
+func diffQuadratic[T comparable](s []T, others ...[]T) []T {
+	if len(others) == 0 {
+		return s
+	}
+	result := Copy(s)
+	for i := range others {
+		for j := range others[i] {
+			if HasValue(s, others[i][j]) {
+				result = RemoveValue(result, others[i][j])
+			}
+		}
+	}
+	return result
+}
+
+func intersectQuadratic[T comparable](s []T, others ...[]T) []T {
+	if len(others) == 0 {
+		return []T{}
+	}
+	sort.Slice(others, func(i, j int) bool {
+		return len(others[i]) < len(others[j])
+	})
+	if len(others[0]) == 0 {
+		return []T{}
+	}
+	result := Copy(s)
+	for i := range others {
+		result = Filter(result, func(t T) bool { return HasValue(others[i], t) })
+		if len(result) == 0 {
+			return result
+		}
+	}
+	return result
+}
+
+func mergeQuadratic[T comparable](s []T, others ...[]T) []T {
+	result := Copy(s)
+	for i := range others {
+		result = append(result, diffQuadratic(others[i], result)...)
+	}
+	return result
+}
+
+func randomInts(r *rand.Rand, n, max int) []int {
+	result := make([]int, n)
+	for i := range result {
+		result[i] = r.Intn(max)
+	}
+	return result
+}
+
+func TestSetOperations_MatchReference(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 500; i++ {
+		s := randomInts(r, r.Intn(20), 15)
+		others := make([][]int, r.Intn(4))
+		for j := range others {
+			others[j] = randomInts(r, r.Intn(20), 15)
+		}
+
+		if got, exp := Diff(s, others...), diffQuadratic(s, others...); !reflect.DeepEqual(got, exp) {
+			t.Fatalf("Diff(%v, %v)"+errorFormat, s, others, got, exp)
+		}
+		if got, exp := Merge(s, others...), mergeQuadratic(s, others...); !reflect.DeepEqual(got, exp) {
+			t.Fatalf("Merge(%v, %v)"+errorFormat, s, others, got, exp)
+		}
+		got := Intersect(s, others...)
+		if exp := intersectQuadratic(s, others...); !reflect.DeepEqual(got, exp) {
+			t.Fatalf("Intersect(%v, %v)"+errorFormat, s, others, got, exp)
+		}
+	}
+}
+
 func TestSafeSlice(t *testing.T) {
 	arr := Copy(stringSlice)
 
@@ -267,6 +353,8 @@ func TestFilter(t *testing.T) {
 	}{
 		{name: "empty", input: []int{}, f: nil, exp: []int{}},
 		{name: "123", input: intSlice, f: func(i int) bool { return i < 4 }, exp: []int{1, 2, 3}},
+		{name: "none", input: intSlice, f: func(i int) bool { return false }, exp: []int{}},
+		{name: "odd", input: intSlice, f: func(i int) bool { return i%2 == 1 }, exp: []int{1, 3, 5}},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -275,6 +363,40 @@ func TestFilter(t *testing.T) {
 			}
 		})
 	}
+	if exp := []int{1, 2, 3, 4, 5}; !reflect.DeepEqual(intSlice, exp) {
+		t.Errorf(errorFormat, intSlice, exp)
+	}
+}
+
+func TestFilterInPlace(t *testing.T) {
+	tests := []struct {
+		name  string
+		input []int
+		f     func(int) bool
+		exp   []int
+	}{
+		{name: "empty", input: []int{}, f: nil, exp: []int{}},
+		{name: "nil func", input: []int{1, 2}, f: nil, exp: []int{1, 2}},
+		{name: "none", input: []int{1, 2, 3}, f: func(i int) bool { return false }, exp: []int{}},
+		{name: "odd", input: []int{1, 2, 3, 4, 5}, f: func(i int) bool { return i%2 == 1 }, exp: []int{1, 3, 5}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			input := Copy(tt.input)
+			got := FilterInPlace(input, tt.f)
+			if !reflect.DeepEqual(got, tt.exp) {
+				t.Errorf(errorFormat, got, tt.exp)
+			}
+			if len(got) > 0 && &got[0] != &input[0] {
+				t.Errorf(errorFormat, &got[0], &input[0])
+			}
+			for _, v := range input[len(got):] {
+				if tt.f != nil && v != 0 {
+					t.Errorf(errorFormat, input, tt.exp)
+				}
+			}
+		})
+	}
 }
 
 func TestMap(t *testing.T) {
@@ -296,6 +418,93 @@ func TestMap(t *testing.T) {
 	}
 }
 
+func TestMapTo(t *testing.T) {
+	tests := []struct {
+		name  string
+		input []testStruct
+		f     func(testStruct) string
+		exp   []string
+	}{
+		{name: "nil func", input: []testStruct{{1}}, f: nil, exp: []string{}},
+		{name: "empty", input: []testStruct{}, f: testStruct.String, exp: []string{}},
+		{name: "ids", input: []testStruct{{1}, {2}}, f: testStruct.String, exp: []string{"1", "2"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := MapTo(tt.input, tt.f); !reflect.DeepEqual(got, tt.exp) {
+				t.Errorf(errorFormat, got, tt.exp)
+			}
+		})
+	}
+}
+
+func TestFlatMap(t *testing.T) {
+	tests := []struct {
+		name  string
+		input []int
+		f     func(int) []string
+		exp   []string
+	}{
+		{name: "nil func", input: intSlice, f: nil, exp: []string{}},
+		{name: "empty", input: []int{}, f: func(i int) []string { return []string{"x"} }, exp: []string{}},
+		{name: "repeat", input: []int{0, 1, 2}, f: func(i int) []string { return Fill(fmt.Sprint(i), i) }, exp: []string{"1", "2", "2"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := FlatMap(tt.input, tt.f); !reflect.DeepEqual(got, tt.exp) {
+				t.Errorf(errorFormat, got, tt.exp)
+			}
+		})
+	}
+}
+
+func TestReduce(t *testing.T) {
+	concat := func(acc string, i int) string { return acc + fmt.Sprint(i) }
+	tests := []struct {
+		name    string
+		input   []int
+		initial string
+		f       func(string, int) string
+		exp     string
+		expR    string
+	}{
+		{name: "nil func", input: intSlice, initial: "x", f: nil, exp: "x", expR: "x"},
+		{name: "empty", input: []int{}, initial: "x", f: concat, exp: "x", expR: "x"},
+		{name: "concat", input: intSlice, initial: ">", f: concat, exp: ">12345", expR: ">54321"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Reduce(tt.input, tt.initial, tt.f); got != tt.exp {
+				t.Errorf(errorFormat, got, tt.exp)
+			}
+			if got := ReduceRight(tt.input, tt.initial, tt.f); got != tt.expR {
+				t.Errorf(errorFormat, got, tt.expR)
+			}
+		})
+	}
+}
+
+func TestScan(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   []int
+		initial int
+		f       func(int, int) int
+		exp     []int
+	}{
+		{name: "nil func", input: intSlice, f: nil, exp: []int{}},
+		{name: "empty", input: []int{}, f: func(a, b int) int { return a + b }, exp: []int{}},
+		{name: "running sum", input: intSlice, initial: 10, f: func(a, b int) int { return a + b }, exp: []int{11, 13, 16, 20, 25}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Scan(tt.input, tt.initial, tt.f); !reflect.DeepEqual(got, tt.exp) {
+				t.Errorf(errorFormat, got, tt.exp)
+			}
+		})
+	}
+}
+
 func TestConvert(t *testing.T) {
 	tests := []struct {
 		name  string
@@ -474,3 +683,89 @@ func TestSequenceGenerator(t *testing.T) {
 		})
 	}
 }
+
+var benchSizes = []int{1_000, 10_000, 100_000}
+
+func benchFilter(b *testing.B, filter func([]int, func(int) bool) []int, sizes []int) {
+	for _, n := range sizes {
+		s := Range(0, n, 1)
+		b.Run(fmt.Sprintf("n=%d", n), func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				filter(s, func(v int) bool { return v%2 == 0 })
+			}
+		})
+	}
+}
+
+// filterQuadratic is the former Filter implementation, kept as a benchmark baseline
+func filterQuadratic[T any](s []T, f func(value T) bool) []T {
+	result := Copy(s)
+	for i := 0; i < len(result); {
+		if !f(result[i]) {
+			result = RemoveIdx(result, i)
+			continue
+		}
+		i++
+	}
+	return result
+}
+
+func BenchmarkFilter(b *testing.B) {
+	benchFilter(b, Filter[int], benchSizes)
+}
+
+func BenchmarkFilter_Quadratic(b *testing.B) {
+	benchFilter(b, filterQuadratic[int], benchSizes[:2])
+}
+
+func BenchmarkFilterInPlace(b *testing.B) {
+	for _, n := range benchSizes {
+		src := Range(0, n, 1)
+		s := make([]int, n)
+		b.Run(fmt.Sprintf("n=%d", n), func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				copy(s, src)
+				FilterInPlace(s, func(v int) bool { return v%2 == 0 })
+			}
+		})
+	}
+}
+
+func benchSetOperation(b *testing.B, op func([]int, ...[]int) []int, sizes []int) {
+	for _, n := range sizes {
+		r := rand.New(rand.NewSource(1))
+		s, other := randomInts(r, n, 2*n), randomInts(r, n, 2*n)
+		b.Run(fmt.Sprintf("n=%d", n), func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				op(s, other)
+			}
+		})
+	}
+}
+
+func BenchmarkDiff(b *testing.B) {
+	benchSetOperation(b, Diff[int], benchSizes)
+}
+
+func BenchmarkDiff_Quadratic(b *testing.B) {
+	benchSetOperation(b, diffQuadratic[int], benchSizes[:2])
+}
+
+func BenchmarkIntersect(b *testing.B) {
+	benchSetOperation(b, Intersect[int], benchSizes)
+}
+
+func BenchmarkIntersect_Quadratic(b *testing.B) {
+	benchSetOperation(b, intersectQuadratic[int], benchSizes[:2])
+}
+
+func BenchmarkMerge(b *testing.B) {
+	benchSetOperation(b, Merge[int], benchSizes)
+}
+
+func BenchmarkMerge_Quadratic(b *testing.B) {
+	benchSetOperation(b, mergeQuadratic[int], benchSizes[:2])
+}
@@ -0,0 +1,93 @@
+package slices
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+
+	"github.com/goiste/generics/internal/pgarray"
+)
+
+// JSONArray represents a slice stored as a JSON array;
+// convert a slice with JSONArray[T](s) to use it as a query argument or scan destination
+type JSONArray[T any] []T
+
+// PGArray represents a slice stored as a Postgres array literal (e.g. {1,2,3});
+// convert a slice with PGArray[T](s) to use it as a query argument or scan destination.
+// Elements must be of string, integer, float or bool kind.
+type PGArray[T any] []T
+
+// Value
+// implements driver.Valuer, encoding the slice as a JSON array
+func (a JSONArray[T]) Value() (driver.Value, error) {
+	if a == nil {
+		return nil, nil
+	}
+	data, err := json.Marshal([]T(a))
+	if err != nil {
+		return nil, err
+	}
+	return string(data), nil
+}
+
+// Scan
+// implements sql.Scanner, decoding a JSON array; NULL results in a nil slice
+func (a *JSONArray[T]) Scan(src any) error {
+	data, err := scanBytes(src)
+	if err != nil {
+		return err
+	}
+	if data == nil {
+		*a = nil
+		return nil
+	}
+
+	var values []T
+	if err = json.Unmarshal(data, &values); err != nil {
+		return err
+	}
+	*a = values
+	return nil
+}
+
+// Value
+// implements driver.Valuer, encoding the slice as a Postgres array literal
+func (a PGArray[T]) Value() (driver.Value, error) {
+	if a == nil {
+		return nil, nil
+	}
+	return pgarray.Format([]T(a))
+}
+
+// Scan
+// implements sql.Scanner, decoding a Postgres array literal; NULL results in a nil slice
+func (a *PGArray[T]) Scan(src any) error {
+	data, err := scanBytes(src)
+	if err != nil {
+		return err
+	}
+	if data == nil {
+		*a = nil
+		return nil
+	}
+
+	values, err := pgarray.Parse[T](string(data))
+	if err != nil {
+		return err
+	}
+	*a = values
+	return nil
+}
+
+func scanBytes(src any) ([]byte, error) {
+	switch v := src.(type) {
+	case nil:
+		return nil, nil
+	case []byte:
+		return v, nil
+	case string:
+		return []byte(v), nil
+	default:
+		return nil, fmt.Errorf("slices: cannot scan %T into a slice", src)
+	}
+}
@@ -0,0 +1,131 @@
+package slices
+
+import (
+	"database/sql/driver"
+	"reflect"
+	"testing"
+
+	"github.com/goiste/generics/internal/fakedb"
+)
+
+func TestArray_Value(t *testing.T) {
+	tests := []struct {
+		name  string
+		value driver.Valuer
+		exp   driver.Value
+	}{
+		{name: "json_nil", value: JSONArray[int](nil), exp: nil},
+		{name: "json", value: JSONArray[string](stringSlice), exp: `["one","two","three","four","five"]`},
+		{name: "pg_nil", value: PGArray[int](nil), exp: nil},
+		{name: "pg_int", value: PGArray[int](intSlice), exp: `{1,2,3,4,5}`},
+		{name: "pg_float", value: PGArray[float64]([]float64{1.5, 2}), exp: `{1.5,2}`},
+		{name: "pg_bool", value: PGArray[bool]([]bool{true, false}), exp: `{t,f}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.value.Value()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(got, tt.exp) {
+				t.Errorf(errorFormat, got, tt.exp)
+			}
+		})
+	}
+}
+
+func TestJSONArray_Scan(t *testing.T) {
+	tests := []struct {
+		name    string
+		src     any
+		exp     JSONArray[int]
+		wantErr bool
+	}{
+		{name: "null", src: nil, exp: nil},
+		{name: "values", src: []byte(`[1,2,2]`), exp: JSONArray[int]{1, 2, 2}},
+		{name: "invalid", src: `{1}`, wantErr: true},
+		{name: "type", src: 1.5, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := JSONArray[int]{9}
+			err := got.Scan(tt.src)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf(errorFormat, err, tt.wantErr)
+			}
+			exp := tt.exp
+			if tt.wantErr {
+				exp = JSONArray[int]{9}
+			}
+			if !reflect.DeepEqual(got, exp) {
+				t.Errorf(errorFormat, got, exp)
+			}
+		})
+	}
+}
+
+func TestPGArray_Scan(t *testing.T) {
+	tests := []struct {
+		name    string
+		src     any
+		exp     PGArray[string]
+		wantErr bool
+	}{
+		{name: "null", src: nil, exp: nil},
+		{name: "values", src: []byte(`{a,"b c",a}`), exp: PGArray[string]{"a", "b c", "a"}},
+		{name: "invalid", src: `[1]`, wantErr: true},
+		{name: "type", src: true, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := PGArray[string]{"z"}
+			err := got.Scan(tt.src)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf(errorFormat, err, tt.wantErr)
+			}
+			exp := tt.exp
+			if tt.wantErr {
+				exp = PGArray[string]{"z"}
+			}
+			if !reflect.DeepEqual(got, exp) {
+				t.Errorf(errorFormat, got, exp)
+			}
+		})
+	}
+}
+
+func TestArray_SQLRoundTrip(t *testing.T) {
+	db, err := fakedb.Open()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	t.Run("json", func(t *testing.T) {
+		const key = "INSERT INTO slices_json"
+		if _, err := db.Exec(key, JSONArray[float64](floatSlice)); err != nil {
+			t.Fatal(err)
+		}
+		var got []float64
+		if err := db.QueryRow("SELECT", key).Scan((*JSONArray[float64])(&got)); err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(got, floatSlice) {
+			t.Errorf(errorFormat, got, floatSlice)
+		}
+	})
+
+	t.Run("pg", func(t *testing.T) {
+		const key = "INSERT INTO slices_pg"
+		if _, err := db.Exec(key, PGArray[string](stringSlice)); err != nil {
+			t.Fatal(err)
+		}
+		var got []string
+		if err := db.QueryRow("SELECT", key).Scan((*PGArray[string])(&got)); err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(got, stringSlice) {
+			t.Errorf(errorFormat, got, stringSlice)
+		}
+	})
+}